@@ -1,6 +1,10 @@
 package aircall
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"iter"
+)
 
 // A2P Campaign Associations service
 type A2PCampaignAssociationsService service
@@ -15,14 +19,14 @@ type A2PCampaignAssociationResponse struct {
 }
 
 type A2PCampaignAssociation struct {
-	ID            int         `json:"id,omitempty"`
-	CompanyID     int         `json:"company_id,omitempty"`
-	ExternalID    string      `json:"external_id,omitempty"`
-	UpdateStatus  string      `json:"update_status,omitempty"`
-	UpdateMessage string      `json:"update_message,omitempty"`
-	CreatedAt     interface{} `json:"created_at,omitempty"`
-	UpdatedAt     interface{} `json:"updated_at,omitempty"`
-	Numbers       *[]int      `json:"numbers,omitempty"`
+	ID            int        `json:"id,omitempty"`
+	CompanyID     int        `json:"company_id,omitempty"`
+	ExternalID    string     `json:"external_id,omitempty"`
+	UpdateStatus  string     `json:"update_status,omitempty"`
+	UpdateMessage string     `json:"update_message,omitempty"`
+	CreatedAt     *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt     *Timestamp `json:"updated_at,omitempty"`
+	Numbers       *[]int     `json:"numbers,omitempty"`
 }
 
 type CreateUpdateA2PCampaignAssociation struct {
@@ -61,10 +65,17 @@ func (p ListA2PCampaignAssociationsQueryParams) Paginate(page int, perPage int)
 
 // Get all A2P campaign associations. Reference: https://developer.aircall.io/api-references/#list-a2p-campaign-associations
 func (service *A2PCampaignAssociationsService) List(queryParams *ListA2PCampaignAssociationsQueryParams) (*A2PCampaignAssociationsResponse, *Response, error) {
+	return service.ListContext(context.Background(), queryParams)
+}
+
+// Get all A2P campaign associations using the given context. Reference: https://developer.aircall.io/api-references/#list-a2p-campaign-associations
+func (service *A2PCampaignAssociationsService) ListContext(ctx context.Context, queryParams *ListA2PCampaignAssociationsQueryParams) (*A2PCampaignAssociationsResponse, *Response, error) {
+	ctx = withOperation(ctx, "A2PCampaignAssociations.List")
+
 	_url := "a2p_campaign_associations"
 
 	responseBody := new(A2PCampaignAssociationsResponse)
-	response, err := service.client.Get(_url, queryParams, responseBody)
+	response, err := service.client.GetContext(ctx, _url, queryParams, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -73,6 +84,18 @@ func (service *A2PCampaignAssociationsService) List(queryParams *ListA2PCampaign
 	return responseBody, response, nil
 }
 
+// Iterate over all A2P campaign associations, following pagination. An optional cap on the number
+// of items can be given. Reference: https://developer.aircall.io/api-references/#list-a2p-campaign-associations
+func (service *A2PCampaignAssociationsService) All(ctx context.Context, opts *ListA2PCampaignAssociationsQueryParams, maxItems ...int) iter.Seq2[A2PCampaignAssociation, error] {
+	ctx = withOperation(ctx, "A2PCampaignAssociations.All")
+
+	_url := "a2p_campaign_associations"
+
+	return paginate(ctx, service.client, _url, opts, maxItems, func(page *A2PCampaignAssociationsResponse) (*GenericResponseMeta, *[]A2PCampaignAssociation) {
+		return page.Meta, page.Contacts
+	})
+}
+
 //  ***********************************************************************************
 //  CREATE A2P CAMPAIGN ASSOCIATION
 //  https://developer.aircall.io/api-references/#create-an-a2p-campaign-association
@@ -80,10 +103,17 @@ func (service *A2PCampaignAssociationsService) List(queryParams *ListA2PCampaign
 
 // Create A2P campaign association. Reference: https://developer.aircall.io/api-references/#create-an-a2p-campaign-association
 func (service *A2PCampaignAssociationsService) Create(a2pCampaignAssociation *CreateUpdateA2PCampaignAssociation) (*A2PCampaignAssociationResponse, *Response, error) {
+	return service.CreateContext(context.Background(), a2pCampaignAssociation)
+}
+
+// Create A2P campaign association using the given context. Reference: https://developer.aircall.io/api-references/#create-an-a2p-campaign-association
+func (service *A2PCampaignAssociationsService) CreateContext(ctx context.Context, a2pCampaignAssociation *CreateUpdateA2PCampaignAssociation) (*A2PCampaignAssociationResponse, *Response, error) {
+	ctx = withOperation(ctx, "A2PCampaignAssociations.Create")
+
 	_url := "a2p_campaign_associations"
 
 	responseBody := new(A2PCampaignAssociationResponse)
-	response, err := service.client.Post(_url, a2pCampaignAssociation, responseBody)
+	response, err := service.client.PostContext(ctx, _url, a2pCampaignAssociation, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -99,10 +129,17 @@ func (service *A2PCampaignAssociationsService) Create(a2pCampaignAssociation *Cr
 
 // Update A2P campaign association. Reference: https://developer.aircall.io/api-references/#update-an-a2p-campaign-association
 func (service *A2PCampaignAssociationsService) Update(a2pCampaignAssociation *CreateUpdateA2PCampaignAssociation) (*A2PCampaignAssociationResponse, *Response, error) {
+	return service.UpdateContext(context.Background(), a2pCampaignAssociation)
+}
+
+// Update A2P campaign association using the given context. Reference: https://developer.aircall.io/api-references/#update-an-a2p-campaign-association
+func (service *A2PCampaignAssociationsService) UpdateContext(ctx context.Context, a2pCampaignAssociation *CreateUpdateA2PCampaignAssociation) (*A2PCampaignAssociationResponse, *Response, error) {
+	ctx = withOperation(ctx, "A2PCampaignAssociations.Update")
+
 	_url := "a2p_campaign_associations"
 
 	responseBody := new(A2PCampaignAssociationResponse)
-	response, err := service.client.Put(_url, a2pCampaignAssociation, responseBody)
+	response, err := service.client.PutContext(ctx, _url, a2pCampaignAssociation, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -118,7 +155,14 @@ func (service *A2PCampaignAssociationsService) Update(a2pCampaignAssociation *Cr
 
 // Delete a contact. Reference: https://developer.aircall.io/api-references/#delete-an-a2p-campaign-association
 func (service *A2PCampaignAssociationsService) Delete(a2pCampaignAssociationID int) (*Response, error) {
+	return service.DeleteContext(context.Background(), a2pCampaignAssociationID)
+}
+
+// Delete a contact using the given context. Reference: https://developer.aircall.io/api-references/#delete-an-a2p-campaign-association
+func (service *A2PCampaignAssociationsService) DeleteContext(ctx context.Context, a2pCampaignAssociationID int) (*Response, error) {
+	ctx = withOperation(ctx, "A2PCampaignAssociations.Delete")
+
 	_url := fmt.Sprintf("a2p_campaign_associations/%d", a2pCampaignAssociationID)
 
-	return service.client.Delete(_url)
+	return service.client.DeleteContext(ctx, _url)
 }
@@ -2,6 +2,7 @@ package aircall
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -26,15 +27,35 @@ const (
 )
 
 var (
-	errorDoAllAttemptsExhausted = errors.New("all request attempts were exhausted")
-	errorDoAttemptNilRequest    = errors.New("request could not be constructed")
+	errorDoAttemptNilRequest        = errors.New("request could not be constructed")
+	errorDoRequestBodyNotReplayable = errors.New("request body cannot be replayed for retry")
 )
 
 type ApiType string
 
 type ClientConfig struct {
+	// HTTP client used to send requests. A client using 'Timeout' is created
+	// when nil.
 	HttpClient      *http.Client
 	RestEndpointURL string
+
+	// Timeout of the default HTTP client. Ignored when 'HttpClient' is set.
+	Timeout time.Duration
+
+	// Appended to the library User-Agent (eg. "my-app/1.0").
+	UserAgent string
+
+	// Retry policy for failed requests. Uses DefaultRetryPolicy when nil.
+	RetryPolicy *RetryPolicy
+
+	// Optional client side limiter pacing requests from all services.
+	RateLimiter *RateLimiter
+}
+
+// TokenSource provides the OAuth access token to use for each request (eg.
+// oauth.TokenSource).
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
 }
 
 type auth struct {
@@ -42,13 +63,18 @@ type auth struct {
 	AccessToken string
 	HeaderName  string
 	Prefix      string
+	Source      TokenSource
 }
 
 type Client struct {
-	config  *ClientConfig
-	client  *http.Client
-	auth    *auth
-	baseURL *url.URL
+	config      *ClientConfig
+	client      *http.Client
+	auth        *auth
+	baseURL     *url.URL
+	userAgent   string
+	retryPolicy *RetryPolicy
+	rateLimiter *RateLimiter
+	middlewares []Middleware
 
 	A2PCampaignAssociations  *A2PCampaignAssociationsService
 	Call                     *CallsService
@@ -72,6 +98,10 @@ type service struct {
 type ErrorResponse struct {
 	Response *http.Response
 
+	// Raw response body and Aircall request ID, when available
+	Body      []byte `json:"-"`
+	RequestID string `json:"-"`
+
 	Message      string `json:"message,omitempty"`
 	ErrorMessage string `json:"error,omitempty"`
 	Troubleshoot string `json:"troubleshoot,omitempty"`
@@ -80,6 +110,9 @@ type ErrorResponse struct {
 
 type Response struct {
 	*http.Response
+
+	// Rate limit state reported by Aircall
+	RateLimit RateLimit
 }
 
 func (response *ErrorResponse) Error() string {
@@ -95,13 +128,23 @@ func (response *ErrorResponse) Error() string {
 		errorString = fmt.Sprintf("%s [%s]", errorString, response.Message)
 	}
 
+	if response.RequestID != "" {
+		errorString = fmt.Sprintf("%s [request_id: %s]", errorString, response.RequestID)
+	}
+
 	return errorString
 }
 
 func NewWithConfig(config ClientConfig) *Client {
-	// Create client
-	config.HttpClient = &http.Client{
-		Timeout: time.Duration(clientTimeout * time.Second),
+	if config.Timeout <= 0 {
+		config.Timeout = clientTimeout * time.Second
+	}
+
+	// Create HTTP client? (keep caller provided client untouched)
+	if config.HttpClient == nil {
+		config.HttpClient = &http.Client{
+			Timeout: config.Timeout,
+		}
 	}
 
 	if config.RestEndpointURL == "" {
@@ -111,7 +154,18 @@ func NewWithConfig(config ClientConfig) *Client {
 	// Create client
 	baseURL, _ := url.Parse(config.RestEndpointURL)
 
+	if config.RetryPolicy == nil {
+		config.RetryPolicy = DefaultRetryPolicy()
+	}
+
 	client := &Client{config: &config, client: config.HttpClient, auth: &auth{}, baseURL: baseURL}
+	client.retryPolicy = config.RetryPolicy.withDefaults()
+	client.userAgent = userAgent
+
+	if config.UserAgent != "" {
+		client.userAgent = fmt.Sprintf("%s %s", userAgent, config.UserAgent)
+	}
+	client.rateLimiter = config.RateLimiter
 
 	// Map services
 	client.A2PCampaignAssociations = &A2PCampaignAssociationsService{client: client}
@@ -140,89 +194,167 @@ func (client *Client) Authenticate(accessToken string) {
 	client.auth.AccessToken = accessToken
 	client.auth.Available = true
 	client.auth.Prefix = defaultOAuthPrefix
+	client.auth.Source = nil
+}
+
+// AuthenticateTokenSource authenticates requests with OAuth access tokens
+// provided by the token source, consulted for every request.
+func (client *Client) AuthenticateTokenSource(source TokenSource) {
+	client.auth.HeaderName = defaultAuthHeaderName
+	client.auth.AccessToken = ""
+	client.auth.Available = true
+	client.auth.Prefix = defaultOAuthPrefix
+	client.auth.Source = source
 }
 
 func (client *Client) AuthenricateBasic(apiID string, apiToken string) {
 	client.auth.HeaderName = defaultAuthHeaderName
 	client.auth.Available = true
 	client.auth.Prefix = defaultAuthPrefix
+	client.auth.Source = nil
 	client.auth.AccessToken = base64.StdEncoding.EncodeToString([]byte(apiID + ":" + apiToken))
 }
 
 // NewRequest creates an API request
 func (client *Client) NewRequest(method, urlStr string, opts interface{}, body interface{}) (*http.Request, error) {
-	// Append Query Params to URL
-	if opts, ok := isPointerWithQueryValues(opts); ok {
-		if v, ok := opts.(QueryValues); ok {
-			urlStr += v.getQueryValues().encode()
-		}
-	}
+	return client.NewRequestWithContext(context.Background(), method, urlStr, opts, body)
+}
 
+// NewRequestWithContext creates an API request bound to the given context
+func (client *Client) NewRequestWithContext(ctx context.Context, method, urlStr string, opts interface{}, body interface{}) (*http.Request, error) {
 	rel, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
 	}
 
+	// Append Query Params to URL (merged with any query already in the URL)
+	if opts, ok := isPointerWithQueryValues(opts); ok {
+		if v, ok := opts.(QueryValues); ok && len(v) > 0 {
+			if err := v.validate(); err != nil {
+				return nil, err
+			}
+
+			query := rel.Query()
+
+			for key, values := range v.getQueryValues() {
+				query[key] = append([]string(nil), values...)
+			}
+
+			rel.RawQuery = query.Encode()
+		}
+	}
+
 	url := client.baseURL.ResolveReference(rel)
 
-	var buf io.ReadWriter
+	// Body is read from a bytes reader so the request can be replayed on retry
+	var buf io.Reader
 	if body != nil {
-		buf = new(bytes.Buffer)
+		data := new(bytes.Buffer)
 
-		err := json.NewEncoder(buf).Encode(body)
+		err := json.NewEncoder(data).Encode(body)
 		if err != nil {
 			return nil, err
 		}
+
+		buf = bytes.NewReader(data.Bytes())
 	}
 
-	req, err := http.NewRequest(method, url.String(), buf)
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), buf)
 	if err != nil {
 		return nil, err
 	}
 
 	if client.auth.Available {
-		req.Header.Add(client.auth.HeaderName, fmt.Sprintf("%s %s", client.auth.Prefix, client.auth.AccessToken))
+		accessToken := client.auth.AccessToken
+
+		if client.auth.Source != nil {
+			accessToken, err = client.auth.Source.Token(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		req.Header.Add(client.auth.HeaderName, fmt.Sprintf("%s %s", client.auth.Prefix, accessToken))
 	}
 
 	req.Header.Add("Accept", acceptedContentType)
 	req.Header.Add("Content-type", acceptedContentType)
-	req.Header.Add("User-Agent", userAgent)
+	req.Header.Add("User-Agent", client.userAgent)
 
 	return req, nil
 }
 
-// Do sends an API request
+// Do sends an API request. Failed attempts are retried following the client
+// retry policy. The request context is honored, including while holding
+// between retry attempts.
 func (client *Client) Do(req *http.Request, v interface{}) (*Response, error) {
+	if req == nil {
+		return nil, errorDoAttemptNilRequest
+	}
+
+	op := &Operation{Name: operationName(req.Context()), Request: req, Result: v}
+
+	return client.handler(client.send)(op)
+}
+
+// send dispatches the operation request, retrying failed attempts
+func (client *Client) send(op *Operation) (*Response, error) {
+	var lastResponse *Response
 	var lastErr error
 
+	req, v := op.Request, op.Result
+
+	if req == nil {
+		return nil, errorDoAttemptNilRequest
+	}
+
+	ctx := req.Context()
+	policy := client.retryPolicy
 	attempts := 0
 
-	for attempts < clientRequestRetryAttempts {
+	for attempts < policy.MaxAttempts {
 		// Hold before this attempt? (ie. not first attempt)
 		if attempts > 0 {
-			time.Sleep(clientRequestRetryHoldMillis * time.Millisecond)
+			var lastHTTPResponse *http.Response
+
+			if lastResponse != nil {
+				lastHTTPResponse = lastResponse.Response
+			}
+
+			if err := sleepContext(ctx, policy.backoff(attempts, lastHTTPResponse)); err != nil {
+				return lastResponse, err
+			}
+		}
+
+		// Pace request? (client side rate limiting)
+		if client.rateLimiter != nil {
+			if err := client.rateLimiter.Wait(ctx); err != nil {
+				return lastResponse, err
+			}
+		}
+
+		// Rewind request body? (ie. not first attempt)
+		attemptReq, err := rewindRequest(req, attempts)
+		if err != nil {
+			return lastResponse, err
 		}
 
 		// Dispatch request attempt
 		attempts++
-		resp, shouldRetry, err := client.doAttempt(req, v)
+		resp, shouldRetry, err := client.doAttempt(attemptReq, v)
 
 		// Return response straight away? (we are done)
 		if !shouldRetry {
 			return resp, err
 		}
 
-		// Should retry: store last error (we are not done)
+		// Should retry: store last response and error (we are not done)
+		lastResponse = resp
 		lastErr = err
 	}
 
-	// Set default error? (all attempts failed, but no error is set)
-	if lastErr == nil {
-		lastErr = errorDoAllAttemptsExhausted
-	}
-
-	// All attempts failed, return last attempt error
-	return nil, lastErr
+	// All attempts failed, return last attempt response and error
+	return lastResponse, &RetryError{Attempts: attempts, Err: lastErr}
 }
 
 func (client *Client) doAttempt(req *http.Request, v interface{}) (*Response, bool, error) {
@@ -232,17 +364,21 @@ func (client *Client) doAttempt(req *http.Request, v interface{}) (*Response, bo
 
 	resp, err := client.client.Do(req)
 
-	if checkRequestRetry(resp, err) {
-		return nil, true, err
+	if err != nil {
+		return nil, client.retryPolicy.shouldRetry(req, resp, err), err
 	}
 
 	defer resp.Body.Close()
 
 	response := newResponse(resp)
 
+	if client.rateLimiter != nil {
+		client.rateLimiter.observe(response.RateLimit, time.Now())
+	}
+
 	err = checkResponse(resp)
 	if err != nil {
-		return response, false, err
+		return response, client.retryPolicy.shouldRetry(req, resp, nil), err
 	}
 
 	if v != nil {
@@ -259,21 +395,44 @@ func (client *Client) doAttempt(req *http.Request, v interface{}) (*Response, bo
 	return response, false, err
 }
 
-func newResponse(httpResponse *http.Response) *Response {
-	response := Response{Response: httpResponse}
+// rewindRequest returns a copy of the request with a fresh body for a retry
+func rewindRequest(req *http.Request, attempts int) (*http.Request, error) {
+	if attempts == 0 || req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
 
-	return &response
+	if req.GetBody == nil {
+		return nil, errorDoRequestBodyNotReplayable
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	attemptReq := req.Clone(req.Context())
+	attemptReq.Body = body
+
+	return attemptReq, nil
 }
 
-// checkRequestRetry checks if should retry request
-func checkRequestRetry(response *http.Response, err error) bool {
-	// Low-level error, or response status is a server error? (HTTP 5xx)
-	if err != nil || response.StatusCode >= 500 {
-		return true
+// sleepContext waits for the given duration, or until the context is done
+func sleepContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
 	}
+}
+
+func newResponse(httpResponse *http.Response) *Response {
+	response := Response{Response: httpResponse, RateLimit: parseRateLimit(httpResponse.Header)}
 
-	// No low-level error (should not retry)
-	return false
+	return &response
 }
 
 // checkResponse checks response for errors
@@ -284,11 +443,12 @@ func checkResponse(response *http.Response) error {
 	}
 
 	// Map response error data (eg. HTTP 4xx)
-	errorResponse := &ErrorResponse{Response: response}
+	errorResponse := &ErrorResponse{Response: response, RequestID: response.Header.Get(headerRequestID)}
 
 	data, err := io.ReadAll(response.Body)
 
 	if err == nil && data != nil {
+		errorResponse.Body = data
 		_ = json.Unmarshal(data, errorResponse)
 	}
 
@@ -296,25 +456,53 @@ func checkResponse(response *http.Response) error {
 }
 
 func (client *Client) Get(url string, opts interface{}, v interface{}) (*Response, error) {
-	req, _ := client.NewRequest("GET", url, opts, nil)
+	return client.GetContext(context.Background(), url, opts, v)
+}
+
+func (client *Client) GetContext(ctx context.Context, url string, opts interface{}, v interface{}) (*Response, error) {
+	req, err := client.NewRequestWithContext(ctx, "GET", url, opts, nil)
+	if err != nil {
+		return nil, err
+	}
 
 	return client.Do(req, v)
 }
 
 func (client *Client) Post(url string, body interface{}, v interface{}) (*Response, error) {
-	req, _ := client.NewRequest("POST", url, nil, body)
+	return client.PostContext(context.Background(), url, body, v)
+}
+
+func (client *Client) PostContext(ctx context.Context, url string, body interface{}, v interface{}) (*Response, error) {
+	req, err := client.NewRequestWithContext(ctx, "POST", url, nil, body)
+	if err != nil {
+		return nil, err
+	}
 
 	return client.Do(req, v)
 }
 
 func (client *Client) Put(url string, body interface{}, v interface{}) (*Response, error) {
-	req, _ := client.NewRequest("PUT", url, nil, body)
+	return client.PutContext(context.Background(), url, body, v)
+}
+
+func (client *Client) PutContext(ctx context.Context, url string, body interface{}, v interface{}) (*Response, error) {
+	req, err := client.NewRequestWithContext(ctx, "PUT", url, nil, body)
+	if err != nil {
+		return nil, err
+	}
 
 	return client.Do(req, v)
 }
 
 func (client *Client) Delete(url string, v ...interface{}) (*Response, error) {
-	req, _ := client.NewRequest("DELETE", url, nil, nil)
+	return client.DeleteContext(context.Background(), url, v...)
+}
+
+func (client *Client) DeleteContext(ctx context.Context, url string, v ...interface{}) (*Response, error) {
+	req, err := client.NewRequestWithContext(ctx, "DELETE", url, nil, nil)
+	if err != nil {
+		return nil, err
+	}
 
 	if len(v) > 0 {
 		return client.Do(req, v[0])
@@ -0,0 +1,206 @@
+package aircalltest
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/dinistavares/go-aircall-api"
+)
+
+// SeedCall stores a call, assigning an ID when missing. Returns the stored call.
+func (server *Server) SeedCall(call aircall.Call) aircall.Call {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if call.ID == 0 {
+		call.ID = server.nextID()
+	}
+
+	if call.StartedAt == 0 {
+		call.StartedAt = int(time.Now().Unix())
+	}
+
+	server.calls[call.ID] = &call
+
+	return call
+}
+
+// SeedCalls stores 'count' inbound answered calls, started every 'interval'
+// from 'start'.
+func (server *Server) SeedCalls(count int, start time.Time, interval time.Duration) []aircall.Call {
+	calls := make([]aircall.Call, 0, count)
+
+	for i := 0; i < count; i++ {
+		startedAt := start.Add(time.Duration(i) * interval).Unix()
+
+		calls = append(calls, server.SeedCall(aircall.Call{
+			Direction:  aircall.CallDirectionInbound,
+			Status:     aircall.CallStatusDone,
+			StartedAt:  int(startedAt),
+			AnsweredAt: int(startedAt) + 5,
+			EndedAt:    int(startedAt) + 65,
+			Duration:   65,
+			RawDigits:  "+33 1 23 45 67 89",
+		}))
+	}
+
+	return calls
+}
+
+// Call returns a stored call
+func (server *Server) Call(callID int) (aircall.Call, bool) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	call, ok := server.calls[callID]
+	if !ok {
+		return aircall.Call{}, false
+	}
+
+	return *call, true
+}
+
+func (server *Server) routeCalls(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/calls", func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, "calls", values(server.calls), callID, callStartedAt)
+	})
+
+	mux.HandleFunc("GET /v1/calls/search", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		calls := []aircall.Call{}
+
+		for _, call := range values(server.calls) {
+			if direction := query.Get("direction"); direction != "" && string(call.Direction) != direction {
+				continue
+			}
+
+			if userID := query.Get("user_id"); userID != "" && (call.User == nil || strconv.Itoa(call.User.ID) != userID) {
+				continue
+			}
+
+			if phoneNumber := query.Get("phone_number"); phoneNumber != "" && !containsFold(call.RawDigits, phoneNumber) {
+				continue
+			}
+
+			calls = append(calls, call)
+		}
+
+		writePage(w, r, "calls", calls, callID, callStartedAt)
+	})
+
+	mux.HandleFunc("GET /v1/calls/{id}", server.withCall(func(w http.ResponseWriter, r *http.Request, call *aircall.Call) {
+		writeJSON(w, http.StatusOK, &aircall.CallResponse{Call: call})
+	}))
+
+	mux.HandleFunc("POST /v1/calls/{id}/comments", server.withCall(func(w http.ResponseWriter, r *http.Request, call *aircall.Call) {
+		comment := aircall.CallComment{}
+
+		if !decodeBody(w, r, &comment) {
+			return
+		}
+
+		if comment.Content == "" {
+			writeValidationError(w, "Content is missing")
+			return
+		}
+
+		comment.ID = server.nextID()
+		comment.PostedAt = int(time.Now().Unix())
+
+		comments := []aircall.CallComment{}
+
+		// Stored slices are replaced, never mutated, as copies share them
+		if call.Comments != nil {
+			comments = slices.Clone(*call.Comments)
+		}
+
+		comments = append(comments, comment)
+		call.Comments = &comments
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	mux.HandleFunc("POST /v1/calls/{id}/tags", server.withCall(func(w http.ResponseWriter, r *http.Request, call *aircall.Call) {
+		body := aircall.CallTags{}
+
+		if !decodeBody(w, r, &body) {
+			return
+		}
+
+		tags := []aircall.CallTag{}
+
+		for _, tagID := range body.Tags {
+			tag, ok := server.tags[tagID]
+			if !ok {
+				writeValidationError(w, "Tag "+strconv.Itoa(tagID)+" does not exist")
+				return
+			}
+
+			tags = append(tags, aircall.CallTag{ID: tag.ID, Name: tag.Name, TaggedAt: int(time.Now().Unix())})
+		}
+
+		call.Tags = &tags
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	mux.HandleFunc("PUT /v1/calls/{id}/archive", server.withCall(func(w http.ResponseWriter, r *http.Request, call *aircall.Call) {
+		call.Archived = true
+
+		writeJSON(w, http.StatusOK, &aircall.CallResponse{Call: call})
+	}))
+
+	mux.HandleFunc("PUT /v1/calls/{id}/unarchive", server.withCall(func(w http.ResponseWriter, r *http.Request, call *aircall.Call) {
+		call.Archived = false
+
+		writeJSON(w, http.StatusOK, &aircall.CallResponse{Call: call})
+	}))
+
+	mux.HandleFunc("DELETE /v1/calls/{id}/recording", server.withCall(func(w http.ResponseWriter, r *http.Request, call *aircall.Call) {
+		call.Recording = ""
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	mux.HandleFunc("DELETE /v1/calls/{id}/voicemail", server.withCall(func(w http.ResponseWriter, r *http.Request, call *aircall.Call) {
+		call.Voicemail = ""
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	// Actions without observable state in the fake
+	for _, action := range []string{"transfers", "pause_recording", "resume_recording", "insight_cards"} {
+		mux.HandleFunc("POST /v1/calls/{id}/"+action, server.withCall(func(w http.ResponseWriter, r *http.Request, call *aircall.Call) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+	}
+}
+
+// withCall resolves the call of the request path, answering HTTP 404 when
+// missing
+func (server *Server) withCall(handler func(w http.ResponseWriter, r *http.Request, call *aircall.Call)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathID(w, r, "id", "call")
+		if !ok {
+			return
+		}
+
+		call, ok := server.calls[id]
+		if !ok {
+			writeNotFound(w, "call")
+			return
+		}
+
+		handler(w, r, call)
+	}
+}
+
+func callID(call aircall.Call) int {
+	return call.ID
+}
+
+func callStartedAt(call aircall.Call) int64 {
+	return int64(call.StartedAt)
+}
@@ -0,0 +1,413 @@
+package aircalltest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/dinistavares/go-aircall-api"
+)
+
+const (
+	// Replacement of scrubbed values
+	Redacted = "[REDACTED]"
+
+	// Encoding of bodies that are not valid UTF-8
+	BodyEncodingBase64 = "base64"
+)
+
+var (
+	ErrCassetteMiss = errors.New("aircalltest: no recorded interaction matches the request")
+
+	// Headers scrubbed from cassettes
+	defaultScrubHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+	// JSON fields and query parameters scrubbed from cassettes
+	defaultScrubKeys = []string{"token", "access_token", "refresh_token", "client_secret", "api_token"}
+)
+
+// Mode of a Recorder.
+type RecorderMode string
+
+const (
+	// Send requests and record them
+	RecorderModeRecord RecorderMode = "record"
+
+	// Answer requests from the cassette, without the network
+	RecorderModeReplay RecorderMode = "replay"
+)
+
+// Cassette holds recorded request and response pairs, stored as JSON.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+type Interaction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// Bodies are stored as recorded, or base64 encoded when not valid UTF-8 (see
+// BodyEncoding).
+type CassetteRequest struct {
+	Method       string      `json:"method"`
+	Path         string      `json:"path"`
+	Query        string      `json:"query,omitempty"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"body_encoding,omitempty"`
+}
+
+type CassetteResponse struct {
+	StatusCode   int         `json:"status_code"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"body_encoding,omitempty"`
+}
+
+// CassetteMissError is returned in replay mode for requests the cassette
+// cannot answer. It matches ErrCassetteMiss.
+type CassetteMissError struct {
+	Method string
+	Path   string
+	Query  string
+
+	// Recorded requests to the same path, to spot query differences
+	Candidates []string
+}
+
+func (e *CassetteMissError) Error() string {
+	message := fmt.Sprintf("%s: %s %s", ErrCassetteMiss, e.Method, e.Path)
+
+	if e.Query != "" {
+		message += "?" + e.Query
+	}
+
+	if len(e.Candidates) > 0 {
+		message += fmt.Sprintf(" (recorded: %s)", strings.Join(e.Candidates, ", "))
+	}
+
+	return message
+}
+
+func (e *CassetteMissError) Is(target error) bool {
+	return target == ErrCassetteMiss
+}
+
+// Recorder is an http.RoundTripper recording requests to a cassette, or
+// replaying them from it. Requests are matched by method, path and canonical
+// query (see aircall.CanonicalQuery); identical requests get the recorded
+// responses in order.
+//
+//	recorder, err := aircalltest.NewRecorder("testdata/calls.json", aircalltest.RecorderModeReplay)
+//
+//	config := aircall.ClientConfig{HttpClient: recorder.HttpClient()}
+type Recorder struct {
+	// Cassette file
+	Path string
+
+	Mode RecorderMode
+
+	// Transport used in record mode. Defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+
+	// Headers, JSON fields and query parameters scrubbed before recording.
+	// Default to authorization headers and token fields.
+	ScrubHeaders []string
+	ScrubKeys    []string
+
+	mutex    sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// NewRecorder creates a recorder. In replay mode, the cassette is loaded
+// from 'path'.
+func NewRecorder(path string, mode RecorderMode) (*Recorder, error) {
+	recorder := &Recorder{
+		Path:         path,
+		Mode:         mode,
+		ScrubHeaders: defaultScrubHeaders,
+		ScrubKeys:    defaultScrubKeys,
+	}
+
+	switch mode {
+	case RecorderModeRecord:
+	case RecorderModeReplay:
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(data, &recorder.cassette); err != nil {
+			return nil, fmt.Errorf("aircalltest: invalid cassette %s: %w", path, err)
+		}
+
+		recorder.used = make([]bool, len(recorder.cassette.Interactions))
+	default:
+		return nil, fmt.Errorf("aircalltest: unknown recorder mode %q", mode)
+	}
+
+	return recorder, nil
+}
+
+// HttpClient returns an HTTP client using the recorder, to be set on
+// aircall.ClientConfig
+func (recorder *Recorder) HttpClient() *http.Client {
+	return &http.Client{Transport: recorder}
+}
+
+// Save writes the recorded cassette to its file
+func (recorder *Recorder) Save() error {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	data, err := json.MarshalIndent(&recorder.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(recorder.Path, append(data, '\n'), 0644)
+}
+
+// Unused returns the recorded interactions not replayed yet, eg. to check a
+// test made every expected request
+func (recorder *Recorder) Unused() []Interaction {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	unused := []Interaction{}
+
+	for i, interaction := range recorder.cassette.Interactions {
+		if !recorder.used[i] {
+			unused = append(unused, interaction)
+		}
+	}
+
+	return unused
+}
+
+func (recorder *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if recorder.Mode == RecorderModeReplay {
+		return recorder.replay(req)
+	}
+
+	return recorder.record(req)
+}
+
+func (recorder *Recorder) replay(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	query := recorder.scrubQuery(req.URL.RawQuery)
+	candidates := []string{}
+
+	for i, interaction := range recorder.cassette.Interactions {
+		recorded := interaction.Request
+
+		if recorded.Method != req.Method || recorded.Path != req.URL.Path {
+			continue
+		}
+
+		if recorded.Query != query || recorder.used[i] {
+			candidates = append(candidates, fmt.Sprintf("%s?%s", recorded.Path, recorded.Query))
+			continue
+		}
+
+		body, err := storedBody(interaction.Response.Body, interaction.Response.BodyEncoding)
+		if err != nil {
+			return nil, fmt.Errorf("aircalltest: invalid cassette %s: %w", recorder.Path, err)
+		}
+
+		recorder.used[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, &CassetteMissError{Method: req.Method, Path: req.URL.Path, Query: query, Candidates: candidates}
+}
+
+func (recorder *Recorder) record(req *http.Request) (*http.Response, error) {
+	transport := recorder.Transport
+
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	var requestBody []byte
+
+	// Send a copy, round trippers must not modify the request
+	outbound := req.Clone(req.Context())
+
+	if req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		req.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		requestBody = data
+		outbound.Body = io.NopCloser(bytes.NewReader(data))
+	}
+
+	response, err := transport.RoundTrip(outbound)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(response.Body)
+	response.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	response.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	interaction := Interaction{
+		Request: CassetteRequest{
+			Method: req.Method,
+			Path:   req.URL.Path,
+			Query:  recorder.scrubQuery(req.URL.RawQuery),
+			Header: recorder.scrubHeader(req.Header),
+		},
+		Response: CassetteResponse{
+			StatusCode: response.StatusCode,
+			Header:     recorder.scrubHeader(response.Header),
+		},
+	}
+
+	interaction.Request.Body, interaction.Request.BodyEncoding = recorder.scrubBody(requestBody)
+	interaction.Response.Body, interaction.Response.BodyEncoding = recorder.scrubBody(responseBody)
+
+	recorder.mutex.Lock()
+	recorder.cassette.Interactions = append(recorder.cassette.Interactions, interaction)
+	recorder.mutex.Unlock()
+
+	return response, nil
+}
+
+func (recorder *Recorder) scrubHeader(header http.Header) http.Header {
+	scrubbed := header.Clone()
+
+	for _, name := range recorder.ScrubHeaders {
+		if scrubbed.Get(name) != "" {
+			scrubbed.Set(name, Redacted)
+		}
+	}
+
+	return scrubbed
+}
+
+// scrubQuery redacts token query parameters and returns the canonical query
+func (recorder *Recorder) scrubQuery(rawQuery string) string {
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return aircall.CanonicalQuery(rawQuery)
+	}
+
+	for key := range values {
+		if recorder.isScrubbedKey(key) {
+			values.Set(key, Redacted)
+		}
+	}
+
+	return aircall.CanonicalQuery(values.Encode())
+}
+
+// scrubBody redacts token fields of JSON bodies, returning the body to store
+// and its encoding. Bodies without token fields are kept byte for byte, bodies
+// that are not valid UTF-8 are base64 encoded.
+func (recorder *Recorder) scrubBody(data []byte) (string, string) {
+	if !utf8.Valid(data) {
+		return base64.StdEncoding.EncodeToString(data), BodyEncodingBase64
+	}
+
+	var body interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	if len(data) == 0 || decoder.Decode(&body) != nil || !recorder.scrubValue(body) {
+		return string(data), ""
+	}
+
+	// Re-encode without escaping HTML, numbers are kept as written
+	scrubbed := bytes.Buffer{}
+	encoder := json.NewEncoder(&scrubbed)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(body); err != nil {
+		return string(data), ""
+	}
+
+	return strings.TrimSuffix(scrubbed.String(), "\n"), ""
+}
+
+// scrubValue redacts token fields in place, reporting whether any was found
+func (recorder *Recorder) scrubValue(value interface{}) bool {
+	scrubbed := false
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			if _, ok := field.(string); ok && recorder.isScrubbedKey(key) {
+				value[key] = Redacted
+				scrubbed = true
+			} else if recorder.scrubValue(field) {
+				scrubbed = true
+			}
+		}
+	case []interface{}:
+		for _, item := range value {
+			if recorder.scrubValue(item) {
+				scrubbed = true
+			}
+		}
+	}
+
+	return scrubbed
+}
+
+// storedBody returns the bytes of a stored body
+func storedBody(body string, encoding string) ([]byte, error) {
+	switch encoding {
+	case "":
+		return []byte(body), nil
+	case BodyEncodingBase64:
+		return base64.StdEncoding.DecodeString(body)
+	}
+
+	return nil, fmt.Errorf("unknown body encoding %q", encoding)
+}
+
+func (recorder *Recorder) isScrubbedKey(key string) bool {
+	return slices.ContainsFunc(recorder.ScrubKeys, func(scrubbed string) bool {
+		return strings.EqualFold(scrubbed, key)
+	})
+}
@@ -0,0 +1,185 @@
+package aircalltest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/dinistavares/go-aircall-api"
+)
+
+// SeedContact stores a contact, assigning an ID when missing. Returns the
+// stored contact.
+func (server *Server) SeedContact(contact aircall.Contact) aircall.Contact {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return *server.storeContact(contact)
+}
+
+// Contact returns a stored contact
+func (server *Server) Contact(contactID int) (aircall.Contact, bool) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	contact, ok := server.contacts[contactID]
+	if !ok {
+		return aircall.Contact{}, false
+	}
+
+	return *contact, true
+}
+
+func (server *Server) storeContact(contact aircall.Contact) *aircall.Contact {
+	if contact.ID == 0 {
+		contact.ID = server.nextID()
+	}
+
+	if contact.CreatedAt == nil {
+		contact.CreatedAt = aircall.NewTimestamp(time.Now())
+	}
+
+	if contact.UpdatedAt == nil {
+		contact.UpdatedAt = contact.CreatedAt
+	}
+
+	server.contacts[contact.ID] = &contact
+
+	return &contact
+}
+
+func (server *Server) routeContacts(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/contacts", func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, "contacts", values(server.contacts), contactID, contactCreatedAt)
+	})
+
+	mux.HandleFunc("GET /v1/contacts/search", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		contacts := []aircall.Contact{}
+
+		for _, contact := range values(server.contacts) {
+			if phoneNumber := query.Get("phone_number"); phoneNumber != "" && !contactInfoContains(contact.PhoneNumbers, phoneNumber) {
+				continue
+			}
+
+			if email := query.Get("email"); email != "" && !contactInfoContains(contact.Emails, email) {
+				continue
+			}
+
+			contacts = append(contacts, contact)
+		}
+
+		writePage(w, r, "contacts", contacts, contactID, contactCreatedAt)
+	})
+
+	mux.HandleFunc("POST /v1/contacts", func(w http.ResponseWriter, r *http.Request) {
+		body := aircall.CreateUpdateContact{}
+
+		if !decodeBody(w, r, &body) {
+			return
+		}
+
+		if len(body.PhoneNumbers) == 0 {
+			writeValidationError(w, "Phone numbers are missing")
+			return
+		}
+
+		contact := server.storeContact(aircall.Contact{})
+		applyContact(contact, &body)
+
+		writeJSON(w, http.StatusCreated, &aircall.ContactResponse{Contact: contact})
+	})
+
+	mux.HandleFunc("GET /v1/contacts/{id}", server.withContact(func(w http.ResponseWriter, r *http.Request, contact *aircall.Contact) {
+		writeJSON(w, http.StatusOK, &aircall.ContactResponse{Contact: contact})
+	}))
+
+	mux.HandleFunc("POST /v1/contacts/{id}", server.withContact(func(w http.ResponseWriter, r *http.Request, contact *aircall.Contact) {
+		body := aircall.CreateUpdateContact{}
+
+		if !decodeBody(w, r, &body) {
+			return
+		}
+
+		applyContact(contact, &body)
+
+		writeJSON(w, http.StatusOK, &aircall.ContactResponse{Contact: contact})
+	}))
+
+	mux.HandleFunc("DELETE /v1/contacts/{id}", server.withContact(func(w http.ResponseWriter, r *http.Request, contact *aircall.Contact) {
+		delete(server.contacts, contact.ID)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+}
+
+// withContact resolves the contact of the request path, answering HTTP 404
+// when missing
+func (server *Server) withContact(handler func(w http.ResponseWriter, r *http.Request, contact *aircall.Contact)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathID(w, r, "id", "contact")
+		if !ok {
+			return
+		}
+
+		contact, ok := server.contacts[id]
+		if !ok {
+			writeNotFound(w, "contact")
+			return
+		}
+
+		handler(w, r, contact)
+	}
+}
+
+// applyContact sets the provided fields on a contact
+func applyContact(contact *aircall.Contact, body *aircall.CreateUpdateContact) {
+	if body.FirstName != "" {
+		contact.FirstName = body.FirstName
+	}
+
+	if body.LastName != "" {
+		contact.LastName = body.LastName
+	}
+
+	if body.CompanyName != "" {
+		contact.CompanyName = body.CompanyName
+	}
+
+	if body.Information != "" {
+		contact.Information = body.Information
+	}
+
+	if len(body.PhoneNumbers) > 0 {
+		phoneNumbers := append([]aircall.ContactInfo{}, body.PhoneNumbers...)
+		contact.PhoneNumbers = &phoneNumbers
+	}
+
+	if len(body.Emails) > 0 {
+		emails := append([]aircall.ContactInfo{}, body.Emails...)
+		contact.Emails = &emails
+	}
+
+	contact.UpdatedAt = aircall.NewTimestamp(time.Now())
+}
+
+func contactInfoContains(infos *[]aircall.ContactInfo, search string) bool {
+	if infos == nil {
+		return false
+	}
+
+	for _, info := range *infos {
+		if containsFold(info.Value, search) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func contactID(contact aircall.Contact) int {
+	return contact.ID
+}
+
+func contactCreatedAt(contact aircall.Contact) int64 {
+	return unixOf(contact.CreatedAt)
+}
@@ -0,0 +1,98 @@
+package aircalltest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/dinistavares/go-aircall-api"
+)
+
+// Messages returns the messages sent through the fake server, in order
+func (server *Server) Messages() []aircall.Message {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return append([]aircall.Message{}, server.messages...)
+}
+
+func (server *Server) routeMessages(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v1/numbers/{id}/messages/configuration", server.withNumber(func(w http.ResponseWriter, r *http.Request, number *aircall.Number) {
+		configuration := aircall.NumberConfiguration{}
+
+		if !decodeBody(w, r, &configuration) {
+			return
+		}
+
+		if configuration.CallbackURL == "" {
+			writeValidationError(w, "Callback URL is missing")
+			return
+		}
+
+		if configuration.Token == "" {
+			configuration.Token = newToken()
+		}
+
+		server.configurations[number.ID] = &configuration
+
+		writeJSON(w, http.StatusCreated, &configuration)
+	}))
+
+	mux.HandleFunc("GET /v1/numbers/{id}/messages/configuration", server.withNumber(func(w http.ResponseWriter, r *http.Request, number *aircall.Number) {
+		configuration, ok := server.configurations[number.ID]
+		if !ok {
+			writeNotFound(w, "number configuration")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, configuration)
+	}))
+
+	mux.HandleFunc("DELETE /v1/numbers/{id}/messages/configuration", server.withNumber(func(w http.ResponseWriter, r *http.Request, number *aircall.Number) {
+		if _, ok := server.configurations[number.ID]; !ok {
+			writeNotFound(w, "number configuration")
+			return
+		}
+
+		delete(server.configurations, number.ID)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	mux.HandleFunc("POST /v1/numbers/{id}/messages/send", server.withNumber(func(w http.ResponseWriter, r *http.Request, number *aircall.Number) {
+		body := aircall.NewMessage{}
+
+		if !decodeBody(w, r, &body) {
+			return
+		}
+
+		if body.To == "" || (body.Body == "" && len(body.MediaURL) == 0) {
+			writeValidationError(w, "Recipient and body are required")
+			return
+		}
+
+		now := aircall.NewTimestamp(time.Now())
+
+		message := aircall.Message{
+			ID:             strconv.Itoa(server.nextID()),
+			Direction:      "outbound",
+			ExternalNumber: body.To,
+			Body:           body.Body,
+			Status:         aircall.MessageStatusSent,
+			RawDigits:      body.To,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+			SentAt:         now,
+			Number:         &aircall.Number{ID: number.ID, Name: number.Name, Digits: number.Digits},
+		}
+
+		if len(body.MediaURL) > 0 {
+			mediaURL := append([]string{}, body.MediaURL...)
+			message.MediaURL = &mediaURL
+		}
+
+		server.messages = append(server.messages, message)
+
+		writeJSON(w, http.StatusCreated, &message)
+	}))
+}
@@ -0,0 +1,110 @@
+package aircalltest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/dinistavares/go-aircall-api"
+)
+
+// SeedNumber stores a number, assigning an ID when missing. Returns the
+// stored number.
+func (server *Server) SeedNumber(number aircall.Number) aircall.Number {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if number.ID == 0 {
+		number.ID = server.nextID()
+	}
+
+	if number.CreatedAt == nil {
+		number.CreatedAt = aircall.NewTimestamp(time.Now())
+	}
+
+	server.numbers[number.ID] = &number
+
+	return number
+}
+
+// Number returns a stored number
+func (server *Server) Number(numberID int) (aircall.Number, bool) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	number, ok := server.numbers[numberID]
+	if !ok {
+		return aircall.Number{}, false
+	}
+
+	return *number, true
+}
+
+func (server *Server) routeNumbers(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/numbers", func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, "numbers", values(server.numbers), numberID, numberCreatedAt)
+	})
+
+	mux.HandleFunc("GET /v1/numbers/{id}", server.withNumber(func(w http.ResponseWriter, r *http.Request, number *aircall.Number) {
+		writeJSON(w, http.StatusOK, &aircall.NumberResponse{Number: number})
+	}))
+
+	// Updates both number settings and music and messages
+	mux.HandleFunc("PUT /v1/numbers/{id}", server.withNumber(func(w http.ResponseWriter, r *http.Request, number *aircall.Number) {
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid body", err.Error())
+			return
+		}
+
+		updated := *number
+		messages := aircall.Messages{}
+
+		if err := json.Unmarshal(data, &updated); err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid JSON body", err.Error())
+			return
+		}
+
+		if err := json.Unmarshal(data, &messages); err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid JSON body", err.Error())
+			return
+		}
+
+		if messages != (aircall.Messages{}) {
+			updated.Messages = &messages
+		}
+
+		updated.ID = number.ID
+		*number = updated
+
+		writeJSON(w, http.StatusOK, &aircall.NumberResponse{Number: number})
+	}))
+}
+
+// withNumber resolves the number of the request path, answering HTTP 404
+// when missing
+func (server *Server) withNumber(handler func(w http.ResponseWriter, r *http.Request, number *aircall.Number)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathID(w, r, "id", "number")
+		if !ok {
+			return
+		}
+
+		number, ok := server.numbers[id]
+		if !ok {
+			writeNotFound(w, "number")
+			return
+		}
+
+		handler(w, r, number)
+	}
+}
+
+func numberID(number aircall.Number) int {
+	return number.ID
+}
+
+func numberCreatedAt(number aircall.Number) int64 {
+	return unixOf(number.CreatedAt)
+}
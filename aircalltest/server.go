@@ -0,0 +1,405 @@
+// Package aircalltest provides an in-memory fake of the Aircall API, served
+// over httptest, so code using aircall.Client can be tested without hand
+// rolled servers.
+//
+//	server := aircalltest.NewServer()
+//	defer server.Close()
+//
+//	server.SeedCalls(120, time.Now().Add(-time.Hour), time.Minute)
+//
+//	client := server.Client()
+package aircalltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dinistavares/go-aircall-api"
+)
+
+const (
+	// Token accepted by the fake server, set on clients returned by Client
+	DefaultAccessToken = "aircalltest-token"
+
+	defaultPerPage = 20
+	maxPerPage     = 50
+
+	// Aircall refuses to paginate past this number of items
+	maxPaginatedItems = 10000
+)
+
+// Server is a fake Aircall API. It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mutex sync.Mutex
+
+	calls    map[int]*aircall.Call
+	contacts map[int]*aircall.Contact
+	users    map[int]*aircall.User
+	teams    map[int]*aircall.Team
+	tags     map[int]*aircall.Tag
+	numbers  map[int]*aircall.Number
+	webhooks map[string]*aircall.Webhook
+	messages []aircall.Message
+
+	configurations map[int]*aircall.NumberConfiguration
+
+	lastID int
+
+	// Rate limiting, disabled when rateLimit is zero
+	rateLimit       int
+	rateLimitPeriod time.Duration
+	windowStart     time.Time
+	windowRequests  int
+
+	requests int
+}
+
+// NewServer starts a fake Aircall API. Close it once done.
+func NewServer() *Server {
+	server := &Server{
+		calls:          map[int]*aircall.Call{},
+		contacts:       map[int]*aircall.Contact{},
+		users:          map[int]*aircall.User{},
+		teams:          map[int]*aircall.Team{},
+		tags:           map[int]*aircall.Tag{},
+		numbers:        map[int]*aircall.Number{},
+		webhooks:       map[string]*aircall.Webhook{},
+		configurations: map[int]*aircall.NumberConfiguration{},
+	}
+
+	server.Server = httptest.NewServer(server.handler())
+
+	return server
+}
+
+// URL of the API, to be used as aircall.ClientConfig.RestEndpointURL
+func (server *Server) RestEndpointURL() string {
+	return server.Server.URL + "/v1/"
+}
+
+// ClientConfig returns a client configuration pointing at the fake server.
+// Retries are disabled so failures surface immediately.
+func (server *Server) ClientConfig() aircall.ClientConfig {
+	return aircall.ClientConfig{
+		HttpClient:      server.Server.Client(),
+		RestEndpointURL: server.RestEndpointURL(),
+		RetryPolicy:     &aircall.RetryPolicy{MaxAttempts: 1},
+	}
+}
+
+// Client returns an authenticated client pointing at the fake server.
+func (server *Server) Client() *aircall.Client {
+	client := aircall.NewWithConfig(server.ClientConfig())
+	client.Authenticate(DefaultAccessToken)
+
+	return client
+}
+
+// SetRateLimit allows 'requests' per 'period', answering HTTP 429 past it.
+// Rate limit headers are set on every response. Zero requests disables it.
+func (server *Server) SetRateLimit(requests int, period time.Duration) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.rateLimit = requests
+	server.rateLimitPeriod = period
+	server.windowStart = time.Time{}
+	server.windowRequests = 0
+}
+
+// Requests returns the number of requests received
+func (server *Server) Requests() int {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return server.requests
+}
+
+func (server *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+
+	server.routeCalls(mux)
+	server.routeContacts(mux)
+	server.routeUsers(mux)
+	server.routeTeams(mux)
+	server.routeTags(mux)
+	server.routeNumbers(mux)
+	server.routeWebhooks(mux)
+	server.routeMessages(mux)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "Not found", "Check the request path")
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			writeError(w, http.StatusUnauthorized, "Unauthorized", "Check your API credentials")
+			return
+		}
+
+		if !server.allow(w, time.Now()) {
+			writeError(w, http.StatusTooManyRequests, "Too many requests", "Wait for the rate limit to reset")
+			return
+		}
+
+		// Handlers share the store lock
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// allow counts the request against the rate limit and sets rate limit headers
+func (server *Server) allow(w http.ResponseWriter, now time.Time) bool {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.requests++
+
+	if server.rateLimit <= 0 {
+		return true
+	}
+
+	if now.Sub(server.windowStart) >= server.rateLimitPeriod {
+		server.windowStart = now
+		server.windowRequests = 0
+	}
+
+	reset := server.windowStart.Add(server.rateLimitPeriod)
+
+	w.Header().Set("X-AircallApi-Limit", strconv.Itoa(server.rateLimit))
+	w.Header().Set("X-AircallApi-Reset", strconv.FormatInt(reset.Unix(), 10))
+
+	if server.windowRequests >= server.rateLimit {
+		w.Header().Set("X-AircallApi-Remaining", "0")
+		w.Header().Set("Retry-After", strconv.Itoa(int(reset.Sub(now).Seconds())+1))
+
+		return false
+	}
+
+	server.windowRequests++
+
+	w.Header().Set("X-AircallApi-Remaining", strconv.Itoa(server.rateLimit-server.windowRequests))
+
+	return true
+}
+
+func (server *Server) nextID() int {
+	server.lastID++
+
+	return server.lastID
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string, troubleshoot string) {
+	writeJSON(w, status, map[string]string{"error": message, "troubleshoot": troubleshoot})
+}
+
+func writeNotFound(w http.ResponseWriter, resource string) {
+	writeError(w, http.StatusNotFound, "Not found", fmt.Sprintf("Check the %s ID", resource))
+}
+
+func writeValidationError(w http.ResponseWriter, message string) {
+	writeError(w, http.StatusUnprocessableEntity, message, "Check the request body")
+}
+
+// decodeBody reads the JSON request body into 'v', answering HTTP 400 when
+// invalid
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid JSON body", err.Error())
+		return false
+	}
+
+	return true
+}
+
+// pathID reads an integer path parameter, answering HTTP 404 when invalid
+func pathID(w http.ResponseWriter, r *http.Request, name string, resource string) (int, bool) {
+	id, err := strconv.Atoi(r.PathValue(name))
+	if err != nil {
+		writeNotFound(w, resource)
+		return 0, false
+	}
+
+	return id, true
+}
+
+// pageQuery holds the list parameters shared by list endpoints
+type pageQuery struct {
+	page       int
+	perPage    int
+	descending bool
+	from       int64
+	to         int64
+}
+
+func parsePageQuery(query url.Values) (*pageQuery, error) {
+	params := &pageQuery{page: 1, perPage: defaultPerPage, to: -1}
+
+	if value := query.Get("page"); value != "" {
+		page, err := strconv.Atoi(value)
+		if err != nil || page < 1 {
+			return nil, fmt.Errorf("invalid page %q", value)
+		}
+
+		params.page = page
+	}
+
+	if value := query.Get("per_page"); value != "" {
+		perPage, err := strconv.Atoi(value)
+		if err != nil || perPage < 1 || perPage > maxPerPage {
+			return nil, fmt.Errorf("invalid per_page %q", value)
+		}
+
+		params.perPage = perPage
+	}
+
+	switch order := query.Get("order"); order {
+	case "", "asc":
+	case "desc":
+		params.descending = true
+	default:
+		return nil, fmt.Errorf("invalid order %q", order)
+	}
+
+	for name, target := range map[string]*int64{"from": &params.from, "to": &params.to} {
+		if value := query.Get(name); value != "" {
+			timestamp, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s %q", name, value)
+			}
+
+			*target = timestamp
+		}
+	}
+
+	return params, nil
+}
+
+// writePage filters, sorts and paginates items like Aircall list endpoints
+func writePage[T any](w http.ResponseWriter, r *http.Request, key string, items []T, id func(T) int, createdAt func(T) int64) {
+	params, err := parsePageQuery(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), "Check the query parameters")
+		return
+	}
+
+	// Sort keys rather than items, cheaper on large seeds
+	type sortKey struct {
+		index   int
+		id      int
+		created int64
+	}
+
+	filtered := []sortKey{}
+
+	for index, item := range items {
+		created := createdAt(item)
+
+		if created < params.from || (params.to >= 0 && created > params.to) {
+			continue
+		}
+
+		filtered = append(filtered, sortKey{index: index, id: id(item), created: created})
+	}
+
+	sort.Slice(filtered, func(i, j int) bool {
+		a, b := filtered[i], filtered[j]
+
+		if a.created != b.created {
+			return a.created < b.created != params.descending
+		}
+
+		return a.id < b.id != params.descending
+	})
+
+	start := (params.page - 1) * params.perPage
+
+	if start >= maxPaginatedItems {
+		writeError(w, http.StatusBadRequest, "Pagination limit reached", "Use 'from' and 'to' to narrow down results")
+		return
+	}
+
+	end := min(start+params.perPage, len(filtered))
+	start = min(start, end)
+
+	meta := &aircall.GenericResponseMeta{
+		Count:       end - start,
+		Total:       len(filtered),
+		CurrentPage: params.page,
+		PerPage:     params.perPage,
+	}
+
+	if end < len(filtered) {
+		meta.NextPageLink = pageLink(r, params.page+1)
+	}
+
+	if params.page > 1 {
+		meta.PreviousPageLink = pageLink(r, params.page-1)
+	}
+
+	page := make([]T, 0, end-start)
+
+	for _, entry := range filtered[start:end] {
+		page = append(page, items[entry.index])
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"meta": meta,
+		key:    page,
+	})
+}
+
+// pageLink returns the absolute URL of another page of the request
+func pageLink(r *http.Request, page int) string {
+	query := r.URL.Query()
+	query.Set("page", strconv.Itoa(page))
+
+	link := url.URL{Scheme: "http", Host: r.Host, Path: r.URL.Path, RawQuery: query.Encode()}
+
+	return link.String()
+}
+
+// unixOf returns the UNIX time of an optional timestamp
+func unixOf(timestamp *aircall.Timestamp) int64 {
+	if timestamp == nil || timestamp.IsZero() {
+		return 0
+	}
+
+	return timestamp.Unix()
+}
+
+func containsFold(value string, search string) bool {
+	return strings.Contains(strings.ToLower(value), strings.ToLower(search))
+}
+
+// values copies the stored items of a map
+func values[K comparable, V any](items map[K]*V) []V {
+	list := make([]V, 0, len(items))
+
+	for _, item := range items {
+		list = append(list, *item)
+	}
+
+	return list
+}
@@ -0,0 +1,336 @@
+package aircalltest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/dinistavares/go-aircall-api"
+)
+
+// IDs of simulated calls, far from the IDs used by Server seeds
+var lastSimulatedCallID atomic.Int64
+
+func init() {
+	lastSimulatedCallID.Store(1000000)
+}
+
+// CallScenario scripts the life of a call and builds the webhook events
+// Aircall sends for it, in order. Each step happens one second after the
+// previous one unless Wait is used.
+//
+//	scenario := aircalltest.NewInboundCall("+33 1 23 45 67 89", number)
+//	scenario.Ring(alice, bob)
+//	scenario.Answer(alice)
+//	scenario.Tag(vip, alice)
+//	scenario.Hangup()
+type CallScenario struct {
+	call   aircall.Call
+	now    time.Time
+	events []aircall.InboundWebhook
+}
+
+// NewInboundCall starts a scenario with an inbound call from 'from' to the
+// number. The call.created event is emitted right away.
+func NewInboundCall(from string, number aircall.Number) *CallScenario {
+	return newCallScenario(aircall.CallDirectionInbound, from, number, nil)
+}
+
+// NewOutboundCall starts a scenario with an outbound call placed by the user
+// to 'to'. The call.created event is emitted right away.
+func NewOutboundCall(user aircall.User, to string, number aircall.Number) *CallScenario {
+	return newCallScenario(aircall.CallDirectionOutbound, to, number, &user)
+}
+
+func newCallScenario(direction aircall.CallDirection, digits string, number aircall.Number, user *aircall.User) *CallScenario {
+	now := time.Now().Truncate(time.Second)
+	id := int(lastSimulatedCallID.Add(1))
+
+	scenario := &CallScenario{
+		now: now,
+		call: aircall.Call{
+			ID:         id,
+			DirectLink: fmt.Sprintf("https://api.aircall.io/v1/calls/%d", id),
+			Direction:  direction,
+			Status:     aircall.CallStatusInitial,
+			StartedAt:  int(now.Unix()),
+			RawDigits:  digits,
+			Number:     &number,
+			User:       user,
+		},
+	}
+
+	scenario.emit(aircall.WebhookEventCallCreated)
+
+	return scenario
+}
+
+// Call returns the current state of the simulated call, eg. to seed it on a
+// Server
+func (scenario *CallScenario) Call() aircall.Call {
+	return scenario.call
+}
+
+// Events returns the webhook events emitted so far, in order
+func (scenario *CallScenario) Events() []aircall.InboundWebhook {
+	return slices.Clone(scenario.events)
+}
+
+// Wait delays the next step
+func (scenario *CallScenario) Wait(duration time.Duration) *CallScenario {
+	scenario.now = scenario.now.Add(duration)
+
+	return scenario
+}
+
+// Ring rings the call on the users, at once
+func (scenario *CallScenario) Ring(users ...aircall.User) *CallScenario {
+	scenario.step()
+
+	for _, user := range users {
+		scenario.call.User = &user
+
+		scenario.emit(aircall.WebhookEventCallRingingOnAgent)
+	}
+
+	return scenario
+}
+
+// Decline has the user decline the ringing call
+func (scenario *CallScenario) Decline(user aircall.User) *CallScenario {
+	scenario.step()
+
+	scenario.call.User = &user
+
+	return scenario.emit(aircall.WebhookEventCallAgentDeclined)
+}
+
+// Answer has the user pick up the call
+func (scenario *CallScenario) Answer(user aircall.User) *CallScenario {
+	scenario.step()
+
+	scenario.call.User = &user
+	scenario.call.Status = aircall.CallStatusAnswered
+
+	if scenario.call.AnsweredAt == 0 {
+		scenario.call.AnsweredAt = int(scenario.now.Unix())
+	}
+
+	return scenario.emit(aircall.WebhookEventCallAnswered)
+}
+
+// Transfer has the current user transfer the call to another user
+func (scenario *CallScenario) Transfer(to aircall.User) *CallScenario {
+	scenario.step()
+
+	scenario.call.TransferredBy = scenario.call.User
+	scenario.call.TransferredTo = &to
+
+	return scenario.emit(aircall.WebhookEventCallTransferred)
+}
+
+// Tag has the user tag the call
+func (scenario *CallScenario) Tag(tag aircall.Tag, by aircall.User) *CallScenario {
+	scenario.step()
+
+	tags := []aircall.CallTag{}
+
+	if scenario.call.Tags != nil {
+		tags = slices.Clone(*scenario.call.Tags)
+	}
+
+	tags = append(tags, aircall.CallTag{ID: tag.ID, Name: tag.Name, TaggedAt: int(scenario.now.Unix()), TaggedBy: actionBy(by)})
+	scenario.call.Tags = &tags
+
+	return scenario.emit(aircall.WebhookEventCallTagged)
+}
+
+// Comment has the user comment the call
+func (scenario *CallScenario) Comment(content string, by aircall.User) *CallScenario {
+	scenario.step()
+
+	comments := []aircall.CallComment{}
+
+	if scenario.call.Comments != nil {
+		comments = slices.Clone(*scenario.call.Comments)
+	}
+
+	comments = append(comments, aircall.CallComment{ID: len(comments) + 1, Content: content, PostedAt: int(scenario.now.Unix()), PostedBy: actionBy(by)})
+	scenario.call.Comments = &comments
+
+	return scenario.emit(aircall.WebhookEventCallCommented)
+}
+
+// Voicemail has the caller leave a voicemail, then ends the call
+func (scenario *CallScenario) Voicemail(url string) *CallScenario {
+	scenario.step()
+
+	scenario.call.Voicemail = url
+	scenario.emit(aircall.WebhookEventCallVoicemailLeft)
+
+	return scenario.end()
+}
+
+// Hangup ends the call. Unanswered inbound calls are missed with
+// 'agents_did_not_answer', use Miss for another reason.
+func (scenario *CallScenario) Hangup() *CallScenario {
+	if scenario.call.IsInbound() && scenario.call.AnsweredAt == 0 && scenario.call.MissedCallReason == "" {
+		scenario.call.MissedCallReason = aircall.CallMissedReasonAgentsDidNotAnswer
+	}
+
+	return scenario.end()
+}
+
+// Miss ends the unanswered call with the given reason
+func (scenario *CallScenario) Miss(reason aircall.CallMissedReason) *CallScenario {
+	scenario.call.MissedCallReason = reason
+
+	return scenario.end()
+}
+
+// end emits call.hungup then call.ended
+func (scenario *CallScenario) end() *CallScenario {
+	scenario.step()
+
+	scenario.call.Status = aircall.CallStatusDone
+	scenario.call.EndedAt = int(scenario.now.Unix())
+	scenario.call.Duration = scenario.call.EndedAt - scenario.call.StartedAt
+
+	scenario.emit(aircall.WebhookEventCallHungup)
+
+	if scenario.call.AnsweredAt > 0 {
+		scenario.call.Recording = fmt.Sprintf("https://recordings.aircall.io/%d.mp3", scenario.call.ID)
+	}
+
+	scenario.step()
+
+	return scenario.emit(aircall.WebhookEventCallEnded)
+}
+
+func (scenario *CallScenario) step() {
+	scenario.now = scenario.now.Add(time.Second)
+}
+
+// emit records an event with a snapshot of the call
+func (scenario *CallScenario) emit(event aircall.WebhookEvent) *CallScenario {
+	data, _ := json.Marshal(scenario.call)
+
+	scenario.events = append(scenario.events, aircall.InboundWebhook{
+		Resource:  aircall.WebhookResourceCall,
+		Event:     event,
+		Timestamp: int(scenario.now.Unix()),
+		Data:      data,
+	})
+
+	return scenario
+}
+
+func actionBy(user aircall.User) *aircall.CallActionBy {
+	return &aircall.CallActionBy{
+		ID:                 user.ID,
+		DirectLink:         user.DirectLink,
+		Name:               user.Name,
+		Email:              user.Email,
+		Available:          user.Available,
+		AvailabilityStatus: user.AvailabilityStatus,
+	}
+}
+
+// DeliveryError is returned when a simulated webhook is not acknowledged
+// with HTTP 2xx.
+type DeliveryError struct {
+	Event      aircall.WebhookEvent
+	StatusCode int
+}
+
+func (e *DeliveryError) Error() string {
+	return fmt.Sprintf("aircalltest: %s webhook answered with HTTP %d", e.Event, e.StatusCode)
+}
+
+// Simulator delivers webhook events like Aircall does: one JSON POST per
+// event, in order, carrying the webhook token.
+type Simulator struct {
+	// Webhook token set on every event
+	Token string
+
+	// HTTP client used to deliver to URLs. Defaults to http.DefaultClient.
+	HttpClient *http.Client
+}
+
+func NewSimulator(token string) *Simulator {
+	return &Simulator{Token: token}
+}
+
+// Deliver posts the events to the URL, stopping at the first one not
+// acknowledged.
+func (simulator *Simulator) Deliver(ctx context.Context, url string, events []aircall.InboundWebhook) error {
+	client := simulator.HttpClient
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	for _, event := range events {
+		req, err := simulator.request(ctx, url, event)
+		if err != nil {
+			return err
+		}
+
+		response, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+
+		response.Body.Close()
+
+		if response.StatusCode < 200 || response.StatusCode > 299 {
+			return &DeliveryError{Event: event.Event, StatusCode: response.StatusCode}
+		}
+	}
+
+	return nil
+}
+
+// DeliverHandler serves the events to the handler directly, stopping at the
+// first one not acknowledged.
+func (simulator *Simulator) DeliverHandler(ctx context.Context, handler http.Handler, events []aircall.InboundWebhook) error {
+	for _, event := range events {
+		req, err := simulator.request(ctx, "/", event)
+		if err != nil {
+			return err
+		}
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+
+		if recorder.Code < 200 || recorder.Code > 299 {
+			return &DeliveryError{Event: event.Event, StatusCode: recorder.Code}
+		}
+	}
+
+	return nil
+}
+
+func (simulator *Simulator) request(ctx context.Context, url string, event aircall.InboundWebhook) (*http.Request, error) {
+	event.Token = simulator.Token
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	return req, nil
+}
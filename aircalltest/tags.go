@@ -0,0 +1,117 @@
+package aircalltest
+
+import (
+	"net/http"
+
+	"github.com/dinistavares/go-aircall-api"
+)
+
+// SeedTag stores a tag, assigning an ID when missing. Returns the stored tag.
+func (server *Server) SeedTag(tag aircall.Tag) aircall.Tag {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return *server.storeTag(tag)
+}
+
+// Tag returns a stored tag
+func (server *Server) Tag(tagID int) (aircall.Tag, bool) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	tag, ok := server.tags[tagID]
+	if !ok {
+		return aircall.Tag{}, false
+	}
+
+	return *tag, true
+}
+
+func (server *Server) storeTag(tag aircall.Tag) *aircall.Tag {
+	if tag.ID == 0 {
+		tag.ID = server.nextID()
+	}
+
+	server.tags[tag.ID] = &tag
+
+	return &tag
+}
+
+func (server *Server) routeTags(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/tags", func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, "tags", values(server.tags), tagID, tagCreatedAt)
+	})
+
+	mux.HandleFunc("POST /v1/tags", func(w http.ResponseWriter, r *http.Request) {
+		body := aircall.CreateUpdateTag{}
+
+		if !decodeBody(w, r, &body) {
+			return
+		}
+
+		if body.Name == "" || body.Color == "" {
+			writeValidationError(w, "Name and color are required")
+			return
+		}
+
+		tag := server.storeTag(aircall.Tag{Name: body.Name, Color: body.Color})
+
+		writeJSON(w, http.StatusCreated, &aircall.TagResponse{Tag: tag})
+	})
+
+	mux.HandleFunc("GET /v1/tags/{id}", server.withTag(func(w http.ResponseWriter, r *http.Request, tag *aircall.Tag) {
+		writeJSON(w, http.StatusOK, &aircall.TagResponse{Tag: tag})
+	}))
+
+	mux.HandleFunc("PUT /v1/tags/{id}", server.withTag(func(w http.ResponseWriter, r *http.Request, tag *aircall.Tag) {
+		body := aircall.CreateUpdateTag{}
+
+		if !decodeBody(w, r, &body) {
+			return
+		}
+
+		if body.Name != "" {
+			tag.Name = body.Name
+		}
+
+		if body.Color != "" {
+			tag.Color = body.Color
+		}
+
+		writeJSON(w, http.StatusOK, &aircall.TagResponse{Tag: tag})
+	}))
+
+	mux.HandleFunc("DELETE /v1/tags/{id}", server.withTag(func(w http.ResponseWriter, r *http.Request, tag *aircall.Tag) {
+		delete(server.tags, tag.ID)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+}
+
+// withTag resolves the tag of the request path, answering HTTP 404 when
+// missing
+func (server *Server) withTag(handler func(w http.ResponseWriter, r *http.Request, tag *aircall.Tag)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathID(w, r, "id", "tag")
+		if !ok {
+			return
+		}
+
+		tag, ok := server.tags[id]
+		if !ok {
+			writeNotFound(w, "tag")
+			return
+		}
+
+		handler(w, r, tag)
+	}
+}
+
+func tagID(tag aircall.Tag) int {
+	return tag.ID
+}
+
+// Tags have no creation date, they are listed by ID
+func tagCreatedAt(tag aircall.Tag) int64 {
+	return 0
+}
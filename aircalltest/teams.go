@@ -0,0 +1,155 @@
+package aircalltest
+
+import (
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/dinistavares/go-aircall-api"
+)
+
+// SeedTeam stores a team, assigning an ID when missing. Returns the stored
+// team.
+func (server *Server) SeedTeam(team aircall.Team) aircall.Team {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return *server.storeTeam(team)
+}
+
+// Team returns a stored team
+func (server *Server) Team(teamID int) (aircall.Team, bool) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	team, ok := server.teams[teamID]
+	if !ok {
+		return aircall.Team{}, false
+	}
+
+	return *team, true
+}
+
+func (server *Server) storeTeam(team aircall.Team) *aircall.Team {
+	if team.ID == 0 {
+		team.ID = server.nextID()
+	}
+
+	if team.CreatedAt == nil {
+		team.CreatedAt = aircall.NewTimestamp(time.Now())
+	}
+
+	if team.Users == nil {
+		team.Users = &[]aircall.User{}
+	}
+
+	server.teams[team.ID] = &team
+
+	return &team
+}
+
+func (server *Server) routeTeams(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/teams", func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, "teams", values(server.teams), teamID, teamCreatedAt)
+	})
+
+	mux.HandleFunc("POST /v1/teams", func(w http.ResponseWriter, r *http.Request) {
+		body := aircall.CreateTeam{}
+
+		if !decodeBody(w, r, &body) {
+			return
+		}
+
+		if body.Name == "" {
+			writeValidationError(w, "Name is missing")
+			return
+		}
+
+		for _, team := range server.teams {
+			if team.Name == body.Name {
+				writeValidationError(w, "Name is already taken")
+				return
+			}
+		}
+
+		team := server.storeTeam(aircall.Team{Name: body.Name})
+
+		writeJSON(w, http.StatusCreated, &aircall.TeamResponse{Team: team})
+	})
+
+	mux.HandleFunc("GET /v1/teams/{id}", server.withTeam(func(w http.ResponseWriter, r *http.Request, team *aircall.Team) {
+		writeJSON(w, http.StatusOK, &aircall.TeamResponse{Team: team})
+	}))
+
+	mux.HandleFunc("DELETE /v1/teams/{id}", server.withTeam(func(w http.ResponseWriter, r *http.Request, team *aircall.Team) {
+		delete(server.teams, team.ID)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	mux.HandleFunc("POST /v1/teams/{id}/users/{user_id}", server.withTeam(func(w http.ResponseWriter, r *http.Request, team *aircall.Team) {
+		id, ok := pathID(w, r, "user_id", "user")
+		if !ok {
+			return
+		}
+
+		user, ok := server.users[id]
+		if !ok {
+			writeNotFound(w, "user")
+			return
+		}
+
+		// Stored slices are replaced, never mutated, as copies share them
+		if !slices.ContainsFunc(*team.Users, func(member aircall.User) bool { return member.ID == id }) {
+			users := append(slices.Clone(*team.Users), *user)
+			team.Users = &users
+		}
+
+		writeJSON(w, http.StatusCreated, &aircall.TeamResponse{Team: team})
+	}))
+
+	mux.HandleFunc("DELETE /v1/teams/{id}/users/{user_id}", server.withTeam(func(w http.ResponseWriter, r *http.Request, team *aircall.Team) {
+		id, ok := pathID(w, r, "user_id", "user")
+		if !ok {
+			return
+		}
+
+		index := slices.IndexFunc(*team.Users, func(member aircall.User) bool { return member.ID == id })
+		if index < 0 {
+			writeNotFound(w, "user")
+			return
+		}
+
+		users := slices.Delete(slices.Clone(*team.Users), index, index+1)
+		team.Users = &users
+
+		writeJSON(w, http.StatusOK, &aircall.TeamResponse{Team: team})
+	}))
+}
+
+// withTeam resolves the team of the request path, answering HTTP 404 when
+// missing
+func (server *Server) withTeam(handler func(w http.ResponseWriter, r *http.Request, team *aircall.Team)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathID(w, r, "id", "team")
+		if !ok {
+			return
+		}
+
+		team, ok := server.teams[id]
+		if !ok {
+			writeNotFound(w, "team")
+			return
+		}
+
+		handler(w, r, team)
+	}
+}
+
+func teamID(team aircall.Team) int {
+	return team.ID
+}
+
+func teamCreatedAt(team aircall.Team) int64 {
+	return unixOf(team.CreatedAt)
+}
@@ -0,0 +1,169 @@
+package aircalltest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dinistavares/go-aircall-api"
+)
+
+// SeedUser stores a user, assigning an ID when missing. Returns the stored
+// user.
+func (server *Server) SeedUser(user aircall.User) aircall.User {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return *server.storeUser(user)
+}
+
+// User returns a stored user
+func (server *Server) User(userID int) (aircall.User, bool) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	user, ok := server.users[userID]
+	if !ok {
+		return aircall.User{}, false
+	}
+
+	return *user, true
+}
+
+func (server *Server) storeUser(user aircall.User) *aircall.User {
+	if user.ID == 0 {
+		user.ID = server.nextID()
+	}
+
+	if user.CreatedAt == nil {
+		user.CreatedAt = aircall.NewTimestamp(time.Now())
+	}
+
+	if user.AvailabilityStatus == "" {
+		user.AvailabilityStatus = aircall.UserAvailabilityStatusAvailable
+		user.Available = true
+	}
+
+	server.users[user.ID] = &user
+
+	return &user
+}
+
+func (server *Server) routeUsers(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/users", func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, "users", values(server.users), userID, userCreatedAt)
+	})
+
+	mux.HandleFunc("GET /v1/users/availabilities", func(w http.ResponseWriter, r *http.Request) {
+		availabilities := []aircall.UserAvailability{}
+
+		for _, user := range server.users {
+			availabilities = append(availabilities, aircall.UserAvailability{ID: user.ID, Availability: string(user.AvailabilityStatus)})
+		}
+
+		writePage(w, r, "users", availabilities, func(availability aircall.UserAvailability) int {
+			return availability.ID
+		}, func(availability aircall.UserAvailability) int64 {
+			return unixOf(server.users[availability.ID].CreatedAt)
+		})
+	})
+
+	mux.HandleFunc("POST /v1/users", func(w http.ResponseWriter, r *http.Request) {
+		body := aircall.CreateUpdateUser{}
+
+		if !decodeBody(w, r, &body) {
+			return
+		}
+
+		if body.Email == "" {
+			writeValidationError(w, "Email is missing")
+			return
+		}
+
+		for _, user := range server.users {
+			if strings.EqualFold(user.Email, body.Email) {
+				writeValidationError(w, "Email is already taken")
+				return
+			}
+		}
+
+		user := server.storeUser(aircall.User{})
+		applyUser(user, &body)
+
+		writeJSON(w, http.StatusCreated, &aircall.UserResponse{User: user})
+	})
+
+	mux.HandleFunc("GET /v1/users/{id}", server.withUser(func(w http.ResponseWriter, r *http.Request, user *aircall.User) {
+		writeJSON(w, http.StatusOK, &aircall.UserResponse{User: user})
+	}))
+
+	mux.HandleFunc("PUT /v1/users/{id}", server.withUser(func(w http.ResponseWriter, r *http.Request, user *aircall.User) {
+		body := aircall.CreateUpdateUser{}
+
+		if !decodeBody(w, r, &body) {
+			return
+		}
+
+		if body.AvailabilityStatus != "" && !body.AvailabilityStatus.IsValid() {
+			writeValidationError(w, "Availability status is invalid")
+			return
+		}
+
+		applyUser(user, &body)
+
+		writeJSON(w, http.StatusOK, &aircall.UserResponse{User: user})
+	}))
+
+	mux.HandleFunc("DELETE /v1/users/{id}", server.withUser(func(w http.ResponseWriter, r *http.Request, user *aircall.User) {
+		delete(server.users, user.ID)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+}
+
+// withUser resolves the user of the request path, answering HTTP 404 when
+// missing
+func (server *Server) withUser(handler func(w http.ResponseWriter, r *http.Request, user *aircall.User)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathID(w, r, "id", "user")
+		if !ok {
+			return
+		}
+
+		user, ok := server.users[id]
+		if !ok {
+			writeNotFound(w, "user")
+			return
+		}
+
+		handler(w, r, user)
+	}
+}
+
+// applyUser sets the provided fields on a user
+func applyUser(user *aircall.User, body *aircall.CreateUpdateUser) {
+	if body.Email != "" {
+		user.Email = body.Email
+	}
+
+	if name := strings.TrimSpace(body.FirstName + " " + body.LastName); name != "" {
+		user.Name = name
+	}
+
+	if body.AvailabilityStatus != "" {
+		user.AvailabilityStatus = body.AvailabilityStatus
+		user.Available = body.AvailabilityStatus == aircall.UserAvailabilityStatusAvailable
+	}
+
+	if body.WrapUpTime > 0 {
+		user.WrapUpTime = body.WrapUpTime
+	}
+}
+
+func userID(user aircall.User) int {
+	return user.ID
+}
+
+func userCreatedAt(user aircall.User) int64 {
+	return unixOf(user.CreatedAt)
+}
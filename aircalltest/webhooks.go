@@ -0,0 +1,166 @@
+package aircalltest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/dinistavares/go-aircall-api"
+)
+
+// SeedWebhook stores a webhook, assigning an ID and token when missing. The
+// webhook is stored as given, so inactive unless Active is set. Returns the
+// stored webhook.
+func (server *Server) SeedWebhook(webhook aircall.Webhook) aircall.Webhook {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return *server.storeWebhook(webhook)
+}
+
+// Webhook returns a stored webhook
+func (server *Server) Webhook(webhookID string) (aircall.Webhook, bool) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	webhook, ok := server.webhooks[webhookID]
+	if !ok {
+		return aircall.Webhook{}, false
+	}
+
+	return *webhook, true
+}
+
+func (server *Server) storeWebhook(webhook aircall.Webhook) *aircall.Webhook {
+	if webhook.WebhookID == "" {
+		webhook.WebhookID = newToken()[:16]
+	}
+
+	if webhook.Token == "" {
+		webhook.Token = newToken()
+	}
+
+	if webhook.CreatedAt == nil {
+		webhook.CreatedAt = aircall.NewTimestamp(time.Now())
+	}
+
+	server.webhooks[webhook.WebhookID] = &webhook
+
+	return &webhook
+}
+
+func (server *Server) routeWebhooks(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, "webhooks", values(server.webhooks), server.webhookOrder, webhookCreatedAt)
+	})
+
+	mux.HandleFunc("POST /v1/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		body := aircall.CreateUpdateWebhook{}
+
+		if !decodeBody(w, r, &body) {
+			return
+		}
+
+		if body.URL == "" {
+			writeValidationError(w, "URL is missing")
+			return
+		}
+
+		if err := body.ValidateEvents(); err != nil {
+			writeValidationError(w, err.Error())
+			return
+		}
+
+		webhook := &aircall.Webhook{CustomName: body.CustomName, URL: body.URL, Active: true}
+		applyWebhook(webhook, &body)
+
+		writeJSON(w, http.StatusCreated, &aircall.WebhookResponse{Webhook: server.storeWebhook(*webhook)})
+	})
+
+	mux.HandleFunc("GET /v1/webhooks/{id}", server.withWebhook(func(w http.ResponseWriter, r *http.Request, webhook *aircall.Webhook) {
+		writeJSON(w, http.StatusOK, &aircall.WebhookResponse{Webhook: webhook})
+	}))
+
+	mux.HandleFunc("PUT /v1/webhooks/{id}", server.withWebhook(func(w http.ResponseWriter, r *http.Request, webhook *aircall.Webhook) {
+		body := aircall.CreateUpdateWebhook{}
+
+		if !decodeBody(w, r, &body) {
+			return
+		}
+
+		if err := body.ValidateEvents(); err != nil {
+			writeValidationError(w, err.Error())
+			return
+		}
+
+		applyWebhook(webhook, &body)
+
+		writeJSON(w, http.StatusOK, &aircall.WebhookResponse{Webhook: webhook})
+	}))
+
+	mux.HandleFunc("DELETE /v1/webhooks/{id}", server.withWebhook(func(w http.ResponseWriter, r *http.Request, webhook *aircall.Webhook) {
+		delete(server.webhooks, webhook.WebhookID)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+}
+
+// withWebhook resolves the webhook of the request path, answering HTTP 404
+// when missing
+func (server *Server) withWebhook(handler func(w http.ResponseWriter, r *http.Request, webhook *aircall.Webhook)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		webhook, ok := server.webhooks[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w, "webhook")
+			return
+		}
+
+		handler(w, r, webhook)
+	}
+}
+
+// applyWebhook sets the provided fields on a webhook
+func applyWebhook(webhook *aircall.Webhook, body *aircall.CreateUpdateWebhook) {
+	if body.CustomName != "" {
+		webhook.CustomName = body.CustomName
+	}
+
+	if body.URL != "" {
+		webhook.URL = body.URL
+	}
+
+	if len(body.Events) > 0 {
+		webhook.Events = slices.Clone(body.Events)
+	}
+
+	if body.Active != nil {
+		webhook.Active = *body.Active
+	}
+}
+
+// webhookOrder ranks webhooks by ID, breaking ties between webhooks created
+// the same second (webhook IDs are not numeric)
+func (server *Server) webhookOrder(webhook aircall.Webhook) int {
+	order := 0
+
+	for id := range server.webhooks {
+		if id < webhook.WebhookID {
+			order++
+		}
+	}
+
+	return order
+}
+
+func webhookCreatedAt(webhook aircall.Webhook) int64 {
+	return unixOf(webhook.CreatedAt)
+}
+
+func newToken() string {
+	data := make([]byte, 16)
+	_, _ = rand.Read(data)
+
+	return hex.EncodeToString(data)
+}
@@ -0,0 +1,397 @@
+package aircall
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// Time finished calls are kept to absorb late events
+	DefaultCallTrackerRetention = 10 * time.Minute
+
+	// Time after which calls without any event are dropped
+	DefaultCallTrackerIdleTimeout = 4 * time.Hour
+)
+
+var (
+	ErrInvalidCallTransition = errors.New("aircall: invalid call state transition")
+)
+
+// Lifecycle state of a tracked call.
+type CallState string
+
+const (
+	CallStateUnknown     CallState = ""
+	CallStateCreated     CallState = "created"
+	CallStateRinging     CallState = "ringing"
+	CallStateAnswered    CallState = "answered"
+	CallStateTransferred CallState = "transferred"
+	CallStateHungup      CallState = "hungup"
+	CallStateEnded       CallState = "ended"
+)
+
+// IsFinal reports whether no further transition is expected
+func (state CallState) IsFinal() bool {
+	return state == CallStateEnded
+}
+
+// Events moving a call to a new state. Other call events only refresh the
+// tracked call payload.
+var callStateByEvent = map[WebhookEvent]CallState{
+	WebhookEventCallCreated:        CallStateCreated,
+	WebhookEventCallRingingOnAgent: CallStateRinging,
+	WebhookEventCallAnswered:       CallStateAnswered,
+	WebhookEventCallTransferred:    CallStateTransferred,
+	WebhookEventCallHungup:         CallStateHungup,
+	WebhookEventCallEnded:          CallStateEnded,
+}
+
+// Allowed transitions. Any state may be entered first since the initial
+// events can be lost or delayed.
+var callStateTransitions = map[CallState][]CallState{
+	CallStateUnknown:     {CallStateCreated, CallStateRinging, CallStateAnswered, CallStateTransferred, CallStateHungup, CallStateEnded},
+	CallStateCreated:     {CallStateRinging, CallStateAnswered, CallStateTransferred, CallStateHungup, CallStateEnded},
+	CallStateRinging:     {CallStateRinging, CallStateAnswered, CallStateTransferred, CallStateHungup, CallStateEnded},
+	CallStateAnswered:    {CallStateTransferred, CallStateHungup, CallStateEnded},
+	CallStateTransferred: {CallStateRinging, CallStateAnswered, CallStateTransferred, CallStateHungup, CallStateEnded},
+	CallStateHungup:      {CallStateEnded},
+	CallStateEnded:       {},
+}
+
+// Order of states, late events never move a call back
+var callStateRank = map[CallState]int{
+	CallStateUnknown:     0,
+	CallStateCreated:     1,
+	CallStateRinging:     2,
+	CallStateAnswered:    3,
+	CallStateTransferred: 4,
+	CallStateHungup:      5,
+	CallStateEnded:       6,
+}
+
+// CanTransitionTo reports whether the transition table allows moving to 'next'
+func (state CallState) CanTransitionTo(next CallState) bool {
+	return slices.Contains(callStateTransitions[state], next)
+}
+
+// Event derived from the lifecycle of a call.
+type CallDerivedEvent string
+
+const (
+	// Inbound call hung up by the caller while waiting for an agent
+	CallDerivedEventAbandonedInQueue CallDerivedEvent = "abandoned_in_queue"
+
+	// Call transferred, then never answered by the transfer target
+	CallDerivedEventTransferredThenMissed CallDerivedEvent = "transferred_then_missed"
+)
+
+// CallTransition is a call event applied to a tracked call.
+type CallTransition struct {
+	Event     WebhookEvent
+	From      CallState
+	To        CallState
+	Timestamp time.Time
+
+	// Set when the event arrived after a later one and left the state as is
+	Late bool
+}
+
+// TrackedCall is the state of a call rebuilt from its webhook events.
+type TrackedCall struct {
+	ID    int
+	State CallState
+
+	// Latest call payload received
+	Call *Call
+
+	// Events applied, in arrival order
+	History []CallTransition
+
+	// Derived events emitted for the call
+	Derived []CallDerivedEvent
+
+	// Timestamp of the latest event
+	UpdatedAt time.Time
+
+	// Local time of the last event received, used for expiry
+	seenAt time.Time
+}
+
+// WasTransferred reports whether a transfer event was received
+func (call *TrackedCall) WasTransferred() bool {
+	return slices.ContainsFunc(call.History, func(transition CallTransition) bool {
+		return transition.Event == WebhookEventCallTransferred
+	})
+}
+
+// AnsweredAfterTransfer reports whether an answer event was received after
+// the last transfer
+func (call *TrackedCall) AnsweredAfterTransfer() bool {
+	var transferredAt time.Time
+
+	for _, transition := range call.History {
+		if transition.Event == WebhookEventCallTransferred && transition.Timestamp.After(transferredAt) {
+			transferredAt = transition.Timestamp
+		}
+	}
+
+	for _, transition := range call.History {
+		if transition.Event == WebhookEventCallAnswered && !transition.Timestamp.Before(transferredAt) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (call *TrackedCall) clone() *TrackedCall {
+	tracked := *call
+	tracked.History = slices.Clone(call.History)
+	tracked.Derived = slices.Clone(call.Derived)
+
+	return &tracked
+}
+
+// CallTransitionError is returned when an event moves a call to a state the
+// transition table does not allow. The event payload is still recorded.
+type CallTransitionError struct {
+	CallID int
+	Event  WebhookEvent
+	From   CallState
+	To     CallState
+}
+
+func (e *CallTransitionError) Error() string {
+	return fmt.Sprintf("%s: call %d from %q to %q on %s", ErrInvalidCallTransition, e.CallID, e.From, e.To, e.Event)
+}
+
+func (e *CallTransitionError) Is(target error) bool {
+	return target == ErrInvalidCallTransition
+}
+
+// CallTracker folds call webhook events into per call state. Events may
+// arrive out of order: late ones refresh the payload but never move a call
+// back to an earlier state.
+type CallTracker struct {
+	// Time finished calls are kept. Defaults to DefaultCallTrackerRetention.
+	Retention time.Duration
+
+	// Time after which calls without events are dropped. Defaults to
+	// DefaultCallTrackerIdleTimeout.
+	IdleTimeout time.Duration
+
+	// Called once per derived event, outside of the tracker lock.
+	OnDerivedEvent func(event CallDerivedEvent, call *TrackedCall)
+
+	mutex     sync.Mutex
+	calls     map[int]*TrackedCall
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewCallTracker() *CallTracker {
+	return &CallTracker{
+		Retention:   DefaultCallTrackerRetention,
+		IdleTimeout: DefaultCallTrackerIdleTimeout,
+		calls:       map[int]*TrackedCall{},
+		now:         time.Now,
+	}
+}
+
+// Track applies a call webhook to its call and returns a copy of the updated
+// state. Non call webhooks are ignored and return nil.
+func (tracker *CallTracker) Track(webhook *InboundWebhook) (*TrackedCall, error) {
+	if webhook.Resource != WebhookResourceCall {
+		return nil, nil
+	}
+
+	call, err := webhook.GetCallData()
+	if err != nil {
+		return nil, err
+	}
+
+	tracked, derived, err := tracker.apply(webhook, call)
+
+	if tracker.OnDerivedEvent != nil {
+		for _, event := range derived {
+			tracker.OnDerivedEvent(event, tracked)
+		}
+	}
+
+	return tracked, err
+}
+
+func (tracker *CallTracker) apply(webhook *InboundWebhook, call *Call) (*TrackedCall, []CallDerivedEvent, error) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	if tracker.calls == nil {
+		tracker.calls = map[int]*TrackedCall{}
+	}
+
+	now := tracker.clock()
+	tracker.sweep(now)
+
+	tracked, ok := tracker.calls[call.ID]
+	if !ok {
+		tracked = &TrackedCall{ID: call.ID}
+		tracker.calls[call.ID] = tracked
+	}
+
+	timestamp := unixTime(webhook.Timestamp)
+	late := timestamp.Before(tracked.UpdatedAt)
+	newer := timestamp.After(tracked.UpdatedAt)
+
+	tracked.seenAt = now
+
+	transition := CallTransition{Event: webhook.Event, From: tracked.State, To: tracked.State, Timestamp: timestamp}
+
+	var err error
+
+	if next, ok := callStateByEvent[webhook.Event]; ok {
+		switch {
+		case tracked.State.CanTransitionTo(next):
+			if late && callStateRank[next] < callStateRank[tracked.State] {
+				transition.Late = true
+			} else {
+				transition.To = next
+			}
+		case !newer:
+			// Same second events may arrive in any order
+			transition.Late = true
+		default:
+			err = &CallTransitionError{CallID: call.ID, Event: webhook.Event, From: tracked.State, To: next}
+		}
+	}
+
+	// Keep the freshest payload
+	if !late || tracked.Call == nil {
+		tracked.Call = call
+		tracked.UpdatedAt = timestamp
+	}
+
+	tracked.State = transition.To
+	tracked.History = append(tracked.History, transition)
+
+	derived := tracker.derive(tracked)
+	tracked.Derived = append(tracked.Derived, derived...)
+
+	return tracked.clone(), derived, err
+}
+
+// derive returns the derived events not yet emitted for a finished call
+func (tracker *CallTracker) derive(tracked *TrackedCall) []CallDerivedEvent {
+	if !tracked.State.IsFinal() || tracked.Call == nil {
+		return nil
+	}
+
+	derived := []CallDerivedEvent{}
+
+	emit := func(event CallDerivedEvent) {
+		if !slices.Contains(tracked.Derived, event) {
+			derived = append(derived, event)
+		}
+	}
+
+	call := tracked.Call
+
+	if call.IsInbound() && !call.IsAnswered() {
+		switch call.MissedCallReason {
+		case CallMissedReasonShortAbandoned, CallMissedReasonAbandonedInClassic:
+			emit(CallDerivedEventAbandonedInQueue)
+		}
+	}
+
+	if tracked.WasTransferred() && !tracked.AnsweredAfterTransfer() {
+		emit(CallDerivedEventTransferredThenMissed)
+	}
+
+	return derived
+}
+
+// Get returns a copy of a tracked call
+func (tracker *CallTracker) Get(callID int) (*TrackedCall, bool) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	tracked, ok := tracker.calls[callID]
+	if !ok {
+		return nil, false
+	}
+
+	return tracked.clone(), true
+}
+
+// Len returns the number of tracked calls
+func (tracker *CallTracker) Len() int {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	return len(tracker.calls)
+}
+
+// Expire drops finished calls past their retention and idle calls. Returns
+// the number of calls dropped.
+func (tracker *CallTracker) Expire() int {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	return tracker.expire(tracker.clock())
+}
+
+// sweep expires calls at most once per half retention
+func (tracker *CallTracker) sweep(now time.Time) {
+	if now.Sub(tracker.lastSweep) < tracker.retention()/2 {
+		return
+	}
+
+	tracker.expire(now)
+}
+
+func (tracker *CallTracker) expire(now time.Time) int {
+	tracker.lastSweep = now
+
+	expired := 0
+
+	for id, tracked := range tracker.calls {
+		timeout := tracker.idleTimeout()
+
+		if tracked.State.IsFinal() {
+			timeout = tracker.retention()
+		}
+
+		if now.Sub(tracked.seenAt) >= timeout {
+			delete(tracker.calls, id)
+			expired++
+		}
+	}
+
+	return expired
+}
+
+func (tracker *CallTracker) clock() time.Time {
+	if tracker.now == nil {
+		return time.Now()
+	}
+
+	return tracker.now()
+}
+
+func (tracker *CallTracker) retention() time.Duration {
+	if tracker.Retention <= 0 {
+		return DefaultCallTrackerRetention
+	}
+
+	return tracker.Retention
+}
+
+func (tracker *CallTracker) idleTimeout() time.Duration {
+	if tracker.IdleTimeout <= 0 {
+		return DefaultCallTrackerIdleTimeout
+	}
+
+	return tracker.IdleTimeout
+}
@@ -0,0 +1,233 @@
+package aircall_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dinistavares/go-aircall-api"
+	"github.com/dinistavares/go-aircall-api/aircalltest"
+)
+
+var (
+	trackerNumber = aircall.Number{ID: 1, Digits: "+33 1 00 00 00 00"}
+	trackerAlice  = aircall.User{ID: 10, Name: "Alice"}
+	trackerBob    = aircall.User{ID: 11, Name: "Bob"}
+)
+
+// trackEvents feeds the events to a new tracker, failing on errors, and
+// returns the final state with the derived events reported
+func trackEvents(t *testing.T, events []aircall.InboundWebhook) (*aircall.TrackedCall, []aircall.CallDerivedEvent) {
+	t.Helper()
+
+	reported := []aircall.CallDerivedEvent{}
+
+	tracker := aircall.NewCallTracker()
+	tracker.OnDerivedEvent = func(event aircall.CallDerivedEvent, call *aircall.TrackedCall) {
+		reported = append(reported, event)
+	}
+
+	var tracked *aircall.TrackedCall
+
+	for i := range events {
+		var err error
+
+		if tracked, err = tracker.Track(&events[i]); err != nil {
+			t.Fatalf("Track(%s) error = %v", events[i].Event, err)
+		}
+	}
+
+	return tracked, reported
+}
+
+func reversed(events []aircall.InboundWebhook) []aircall.InboundWebhook {
+	events = slices.Clone(events)
+	slices.Reverse(events)
+
+	return events
+}
+
+func lateEvents(tracked *aircall.TrackedCall) []aircall.WebhookEvent {
+	late := []aircall.WebhookEvent{}
+
+	for _, transition := range tracked.History {
+		if transition.Late {
+			late = append(late, transition.Event)
+		}
+	}
+
+	return late
+}
+
+func TestCallTrackerInOrder(t *testing.T) {
+	scenario := aircalltest.NewInboundCall("+33 6 12 34 56 78", trackerNumber)
+	scenario.Ring(trackerAlice).Answer(trackerAlice).Hangup()
+
+	tracked, derived := trackEvents(t, scenario.Events())
+
+	if tracked.State != aircall.CallStateEnded {
+		t.Errorf("State = %q, want %q", tracked.State, aircall.CallStateEnded)
+	}
+
+	if late := lateEvents(tracked); len(late) > 0 {
+		t.Errorf("late events = %v, want none", late)
+	}
+
+	if len(derived) > 0 {
+		t.Errorf("derived events = %v, want none", derived)
+	}
+
+	if tracked.Call.Status != aircall.CallStatusDone {
+		t.Errorf("Call.Status = %q, want the latest payload", tracked.Call.Status)
+	}
+}
+
+func TestCallTrackerReversed(t *testing.T) {
+	scenario := aircalltest.NewInboundCall("+33 6 12 34 56 78", trackerNumber)
+	scenario.Ring(trackerAlice).Answer(trackerAlice).Hangup()
+
+	events := reversed(scenario.Events())
+
+	tracked, _ := trackEvents(t, events)
+
+	if tracked.State != aircall.CallStateEnded {
+		t.Errorf("State = %q, want %q", tracked.State, aircall.CallStateEnded)
+	}
+
+	// Every event but the first received (call.ended) is older
+	want := []aircall.WebhookEvent{}
+
+	for _, event := range events[1:] {
+		want = append(want, event.Event)
+	}
+
+	if late := lateEvents(tracked); !slices.Equal(late, want) {
+		t.Errorf("late events = %v, want %v", late, want)
+	}
+
+	if tracked.Call.Status != aircall.CallStatusDone {
+		t.Errorf("Call.Status = %q, want the payload of call.ended", tracked.Call.Status)
+	}
+}
+
+func TestCallTrackerInvalidTransition(t *testing.T) {
+	scenario := aircalltest.NewInboundCall("+33 6 12 34 56 78", trackerNumber)
+	scenario.Ring(trackerAlice).Answer(trackerAlice).Hangup()
+
+	events := scenario.Events()
+
+	// Answered again after the call ended
+	answered := slices.IndexFunc(events, func(event aircall.InboundWebhook) bool {
+		return event.Event == aircall.WebhookEventCallAnswered
+	})
+
+	replayed := events[answered]
+	replayed.Timestamp = events[len(events)-1].Timestamp + 60
+
+	tracker := aircall.NewCallTracker()
+
+	for i := range events {
+		if _, err := tracker.Track(&events[i]); err != nil {
+			t.Fatalf("Track(%s) error = %v", events[i].Event, err)
+		}
+	}
+
+	tracked, err := tracker.Track(&replayed)
+
+	var transitionErr *aircall.CallTransitionError
+
+	if !errors.Is(err, aircall.ErrInvalidCallTransition) || !errors.As(err, &transitionErr) {
+		t.Fatalf("Track() error = %v, want a CallTransitionError", err)
+	}
+
+	if transitionErr.From != aircall.CallStateEnded || transitionErr.To != aircall.CallStateAnswered {
+		t.Errorf("CallTransitionError = %+v, want ended to answered", transitionErr)
+	}
+
+	if tracked.State != aircall.CallStateEnded {
+		t.Errorf("State = %q, want %q", tracked.State, aircall.CallStateEnded)
+	}
+
+	// An older invalid event is only late
+	stale := events[answered]
+	stale.Timestamp = events[0].Timestamp
+
+	if _, err := tracker.Track(&stale); err != nil {
+		t.Errorf("Track() late event error = %v, want nil", err)
+	}
+}
+
+func TestCallTrackerDerivedEvents(t *testing.T) {
+	abandoned := aircalltest.NewInboundCall("+33 6 12 34 56 78", trackerNumber)
+	abandoned.Ring(trackerAlice).Miss(aircall.CallMissedReasonShortAbandoned)
+
+	transferredMissed := aircalltest.NewInboundCall("+33 6 12 34 56 78", trackerNumber)
+	transferredMissed.Ring(trackerAlice).Answer(trackerAlice).Transfer(trackerBob).Ring(trackerBob).Hangup()
+
+	transferredAnswered := aircalltest.NewInboundCall("+33 6 12 34 56 78", trackerNumber)
+	transferredAnswered.Ring(trackerAlice).Answer(trackerAlice).Transfer(trackerBob).Answer(trackerBob).Hangup()
+
+	tests := []struct {
+		name     string
+		scenario *aircalltest.CallScenario
+		want     []aircall.CallDerivedEvent
+	}{
+		{"abandoned in queue", abandoned, []aircall.CallDerivedEvent{aircall.CallDerivedEventAbandonedInQueue}},
+		{"transferred then missed", transferredMissed, []aircall.CallDerivedEvent{aircall.CallDerivedEventTransferredThenMissed}},
+		{"transferred then answered", transferredAnswered, []aircall.CallDerivedEvent{}},
+	}
+
+	for _, test := range tests {
+		for _, order := range []string{"in order", "reversed"} {
+			t.Run(test.name+" "+order, func(t *testing.T) {
+				events := test.scenario.Events()
+
+				if order == "reversed" {
+					events = reversed(events)
+				}
+
+				tracked, reported := trackEvents(t, events)
+
+				// Reported once, however many events follow
+				if !slices.Equal(reported, test.want) {
+					t.Errorf("reported derived events = %v, want %v", reported, test.want)
+				}
+
+				if !slices.Equal(tracked.Derived, test.want) {
+					t.Errorf("Derived = %v, want %v", tracked.Derived, test.want)
+				}
+			})
+		}
+	}
+}
+
+func TestCallTrackerExpire(t *testing.T) {
+	tracker := aircall.NewCallTracker()
+	tracker.Retention = time.Millisecond
+
+	ended := aircalltest.NewInboundCall("+33 6 12 34 56 78", trackerNumber)
+	ended.Ring(trackerAlice).Answer(trackerAlice).Hangup()
+
+	ongoing := aircalltest.NewInboundCall("+33 6 12 34 56 78", trackerNumber)
+	ongoing.Ring(trackerAlice)
+
+	// Ended call last, so no sweep while tracking drops it early
+	for _, scenario := range []*aircalltest.CallScenario{ongoing, ended} {
+		for _, event := range scenario.Events() {
+			if _, err := tracker.Track(&event); err != nil {
+				t.Fatalf("Track(%s) error = %v", event.Event, err)
+			}
+		}
+	}
+
+	time.Sleep(5 * time.Millisecond)
+
+	if expired := tracker.Expire(); expired != 1 {
+		t.Errorf("Expire() = %d, want 1", expired)
+	}
+
+	if _, ok := tracker.Get(ongoing.Call().ID); !ok {
+		t.Errorf("ongoing call expired, want it kept until idle")
+	}
+}
@@ -1,7 +1,10 @@
 package aircall
 
 import (
+	"context"
 	"fmt"
+	"iter"
+	"time"
 )
 
 // Call service
@@ -20,9 +23,9 @@ type Call struct {
 	ID                 int                `json:"id,omitempty"`
 	Sid                string             `json:"sid,omitempty"`
 	DirectLink         string             `json:"direct_link,omitempty"`
-	Direction          string             `json:"direction,omitempty"`
-	Status             string             `json:"status,omitempty"`
-	MissedCallReason   string             `json:"missed_call_reason,omitempty"`
+	Direction          CallDirection      `json:"direction,omitempty"`
+	Status             CallStatus         `json:"status,omitempty"`
+	MissedCallReason   CallMissedReason   `json:"missed_call_reason,omitempty"`
 	StartedAt          int                `json:"started_at,omitempty"`
 	AnsweredAt         int                `json:"answered_at,omitempty"`
 	EndedAt            int                `json:"ended_at,omitempty"`
@@ -54,16 +57,16 @@ type CallComment struct {
 }
 
 type CallActionBy struct {
-	ID                 int         `json:"id,omitempty"`
-	DirectLink         string      `json:"direct_link,omitempty"`
-	Name               string      `json:"name,omitempty"`
-	Email              string      `json:"email,omitempty"`
-	Available          bool        `json:"available,omitempty"`
-	AvailabilityStatus string      `json:"availability_status,omitempty"`
-	CreatedAt          interface{} `json:"created_at,omitempty"`
-	TimeZone           string      `json:"time_zone,omitempty"`
-	Language           string      `json:"language,omitempty"`
-	State              string      `json:"state,omitempty"`
+	ID                 int                    `json:"id,omitempty"`
+	DirectLink         string                 `json:"direct_link,omitempty"`
+	Name               string                 `json:"name,omitempty"`
+	Email              string                 `json:"email,omitempty"`
+	Available          bool                   `json:"available,omitempty"`
+	AvailabilityStatus UserAvailabilityStatus `json:"availability_status,omitempty"`
+	CreatedAt          *Timestamp             `json:"created_at,omitempty"`
+	TimeZone           string                 `json:"time_zone,omitempty"`
+	Language           string                 `json:"language,omitempty"`
+	State              string                 `json:"state,omitempty"`
 
 	// Included in Webhook event only
 	Substatus string `json:"substatus,omitempty"`
@@ -72,7 +75,7 @@ type CallActionBy struct {
 type CallTag struct {
 	ID        int           `json:"id,omitempty"`
 	Name      string        `json:"name,omitempty"`
-	CreatedAt interface{}   `json:"created_at,omitempty"`
+	CreatedAt *Timestamp    `json:"created_at,omitempty"`
 	TaggedBy  *CallActionBy `json:"tagged_by,omitempty"`
 
 	// Included in Webhook event only
@@ -103,20 +106,189 @@ type CallTags struct {
 }
 
 type CallParticipant struct {
-	ID          *string `json:"id,omitempty"`
-	Type        string  `json:"type,omitempty"`
-	Name        *string `json:"name,omitempty"`
-	PhoneNumber *string `json:"phone_number,omitempty"`
+	ID          *string             `json:"id,omitempty"`
+	Type        CallParticipantType `json:"type,omitempty"`
+	Name        *string             `json:"name,omitempty"`
+	PhoneNumber *string             `json:"phone_number,omitempty"`
 }
 
 type CallIVROption struct {
-	ID                  string      `json:"id,omitempty"`
-	Title               string      `json:"title,omitempty"`
-	Key                 string      `json:"key,omitempty"`
-	Branch              string      `json:"branch,omitempty"`
-	CreatedAt           interface{} `json:"created_at,omitempty"`
-	TransitionStartedAt string      `json:"transition_started_at,omitempty"`
-	TransitionEndedAt   string      `json:"transition_ended_at,omitempty"`
+	ID                  string     `json:"id,omitempty"`
+	Title               string     `json:"title,omitempty"`
+	Key                 string     `json:"key,omitempty"`
+	Branch              string     `json:"branch,omitempty"`
+	CreatedAt           *Timestamp `json:"created_at,omitempty"`
+	TransitionStartedAt *Timestamp `json:"transition_started_at,omitempty"`
+	TransitionEndedAt   *Timestamp `json:"transition_ended_at,omitempty"`
+}
+
+// IsAnswered reports whether the call was picked up
+func (call *Call) IsAnswered() bool {
+	return call.AnsweredAt > 0
+}
+
+// IsMissed reports whether the call ended without being answered, or was
+// flagged as missed by Aircall
+func (call *Call) IsMissed() bool {
+	if call.MissedCallReason != "" {
+		return true
+	}
+
+	return call.Status == CallStatusDone && !call.IsAnswered()
+}
+
+// IsDone reports whether the call ended
+func (call *Call) IsDone() bool {
+	return call.Status == CallStatusDone
+}
+
+func (call *Call) IsInbound() bool {
+	return call.Direction == CallDirectionInbound
+}
+
+func (call *Call) IsOutbound() bool {
+	return call.Direction == CallDirectionOutbound
+}
+
+// Time the call started
+func (call *Call) StartedTime() time.Time {
+	return unixTime(call.StartedAt)
+}
+
+// Time the call was answered, zero if never answered
+func (call *Call) AnsweredTime() time.Time {
+	return unixTime(call.AnsweredAt)
+}
+
+// Time the call ended, zero if still ongoing
+func (call *Call) EndedTime() time.Time {
+	return unixTime(call.EndedAt)
+}
+
+// Time the comment was posted
+func (comment *CallComment) PostedTime() time.Time {
+	return unixTime(comment.PostedAt)
+}
+
+// Time the call was tagged, zero outside webhook events
+func (tag *CallTag) TaggedTime() time.Time {
+	return unixTime(tag.TaggedAt)
+}
+
+// RingDuration is the time the call rang before being answered or, when
+// never answered, before it ended.
+func (call *Call) RingDuration() time.Duration {
+	if call.AnsweredAt > 0 {
+		return secondsBetween(call.StartedAt, call.AnsweredAt)
+	}
+
+	return secondsBetween(call.StartedAt, call.EndedAt)
+}
+
+// TalkDuration is the time between the call being answered and ending. Zero
+// for unanswered or ongoing calls.
+func (call *Call) TalkDuration() time.Duration {
+	if call.AnsweredAt == 0 {
+		return 0
+	}
+
+	return secondsBetween(call.AnsweredAt, call.EndedAt)
+}
+
+// WaitTime is the time the caller waited before an answer. Zero for
+// unanswered calls.
+func (call *Call) WaitTime() time.Duration {
+	if call.AnsweredAt == 0 {
+		return 0
+	}
+
+	return secondsBetween(call.StartedAt, call.AnsweredAt)
+}
+
+func unixTime(seconds int) time.Time {
+	if seconds <= 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(seconds), 0)
+}
+
+func secondsBetween(from int, to int) time.Duration {
+	if from <= 0 || to < from {
+		return 0
+	}
+
+	return time.Duration(to-from) * time.Second
+}
+
+// Direction of a call.
+type CallDirection string
+
+const (
+	CallDirectionInbound  CallDirection = "inbound"
+	CallDirectionOutbound CallDirection = "outbound"
+)
+
+func (direction CallDirection) IsValid() bool {
+	return direction == CallDirectionInbound || direction == CallDirectionOutbound
+}
+
+// Status of a call.
+type CallStatus string
+
+const (
+	CallStatusInitial  CallStatus = "initial"
+	CallStatusAnswered CallStatus = "answered"
+	CallStatusDone     CallStatus = "done"
+)
+
+func (status CallStatus) IsValid() bool {
+	switch status {
+	case CallStatusInitial, CallStatusAnswered, CallStatusDone:
+		return true
+	}
+
+	return false
+}
+
+// Reason why a call was missed.
+type CallMissedReason string
+
+const (
+	CallMissedReasonOutOfOpeningHours  CallMissedReason = "out_of_opening_hours"
+	CallMissedReasonShortAbandoned     CallMissedReason = "short_abandoned"
+	CallMissedReasonAbandonedInIVR     CallMissedReason = "abandoned_in_ivr"
+	CallMissedReasonAbandonedInClassic CallMissedReason = "abandoned_in_classic"
+	CallMissedReasonNoAvailableAgent   CallMissedReason = "no_available_agent"
+	CallMissedReasonAgentsDidNotAnswer CallMissedReason = "agents_did_not_answer"
+)
+
+func (reason CallMissedReason) IsValid() bool {
+	switch reason {
+	case CallMissedReasonOutOfOpeningHours, CallMissedReasonShortAbandoned, CallMissedReasonAbandonedInIVR,
+		CallMissedReasonAbandonedInClassic, CallMissedReasonNoAvailableAgent, CallMissedReasonAgentsDidNotAnswer:
+		return true
+	}
+
+	return false
+}
+
+// Type of a conference call participant.
+type CallParticipantType string
+
+const (
+	CallParticipantTypeUser     CallParticipantType = "user"
+	CallParticipantTypeContact  CallParticipantType = "contact"
+	CallParticipantTypeExternal CallParticipantType = "external"
+)
+
+func (participantType CallParticipantType) IsValid() bool {
+	switch participantType {
+	case CallParticipantTypeUser, CallParticipantTypeContact, CallParticipantTypeExternal:
+		return true
+	}
+
+	return false
 }
 
 type CallQueries struct{}
@@ -158,9 +330,19 @@ func (p ListCallsQueryParams) To(value string) {
 	p.to(value)
 }
 
+// Set 'from' as a time for 'List' method.
+func (p ListCallsQueryParams) FromTime(value time.Time) {
+	p.fromTime(value)
+}
+
+// Set 'to' as a time for 'List' method.
+func (p ListCallsQueryParams) ToTime(value time.Time) {
+	p.toTime(value)
+}
+
 // Set 'order' for for 'List' method.
-func (p ListCallsQueryParams) Order(value string) {
-	p.order(value)
+func (p ListCallsQueryParams) Order(value SortOrder) {
+	p.order(string(value))
 }
 
 // Set 'fetch_contact' for for 'List' method.
@@ -185,10 +367,17 @@ func (p ListCallsQueryParams) Paginate(page int, perPage int) {
 
 // List calls. Reference: https://developer.aircall.io/api-references/#list-all-calls
 func (service *CallsService) List(opts *ListCallsQueryParams) (*CallsResponse, *Response, error) {
+	return service.ListContext(context.Background(), opts)
+}
+
+// List calls using the given context. Reference: https://developer.aircall.io/api-references/#list-all-calls
+func (service *CallsService) ListContext(ctx context.Context, opts *ListCallsQueryParams) (*CallsResponse, *Response, error) {
+	ctx = withOperation(ctx, "Call.List")
+
 	_url := "calls"
 
 	responseBody := new(CallsResponse)
-	response, err := service.client.Get(_url, opts, responseBody)
+	response, err := service.client.GetContext(ctx, _url, opts, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -197,6 +386,41 @@ func (service *CallsService) List(opts *ListCallsQueryParams) (*CallsResponse, *
 	return responseBody, response, nil
 }
 
+// Iterate over all calls, following pagination. An optional cap on the number
+// of items can be given. Reference: https://developer.aircall.io/api-references/#list-all-calls
+func (service *CallsService) All(ctx context.Context, opts *ListCallsQueryParams, maxItems ...int) iter.Seq2[Call, error] {
+	ctx = withOperation(ctx, "Call.All")
+
+	_url := "calls"
+
+	return paginate(ctx, service.client, _url, opts, maxItems, func(page *CallsResponse) (*GenericResponseMeta, *[]Call) {
+		return page.Meta, page.Calls
+	})
+}
+
+// Iterate over all calls created between 'from' and 'to'. The range is split in
+// smaller windows when it holds more items than Aircall paginates through
+// (10,000). Reference: https://developer.aircall.io/api-references/#list-all-calls
+func (service *CallsService) AllBetween(ctx context.Context, opts *ListCallsQueryParams, from time.Time, to time.Time) iter.Seq2[Call, error] {
+	ctx = withOperation(ctx, "Call.AllBetween")
+
+	_url := "calls"
+
+	var values QueryValues
+
+	if opts != nil {
+		values = opts.QueryValues
+	}
+
+	page := func(page *CallsResponse) (*GenericResponseMeta, *[]Call) {
+		return page.Meta, page.Calls
+	}
+
+	return paginateWindows(ctx, service.client, _url, values, from, to, page, func(item Call) int {
+		return item.ID
+	})
+}
+
 //  ***********************************************************************************
 //  SEARCH CALLS
 //  https://developer.aircall.io/api-references/#search-calls
@@ -219,14 +443,24 @@ func (p SearchCallsQueryParams) To(value string) {
 	p.to(value)
 }
 
+// Set 'from' as a time for 'Search' method.
+func (p SearchCallsQueryParams) FromTime(value time.Time) {
+	p.fromTime(value)
+}
+
+// Set 'to' as a time for 'Search' method.
+func (p SearchCallsQueryParams) ToTime(value time.Time) {
+	p.toTime(value)
+}
+
 // Set 'order' for for 'Search' method.
-func (p SearchCallsQueryParams) Order(value string) {
-	p.order(value)
+func (p SearchCallsQueryParams) Order(value SortOrder) {
+	p.order(string(value))
 }
 
 // Set 'direction' for for 'Search' method.
-func (p SearchCallsQueryParams) Direction(value string) {
-	p.set("direction", value)
+func (p SearchCallsQueryParams) Direction(value CallDirection) {
+	p.set("direction", string(value))
 }
 
 // Set 'phone_number' for for 'Search' method.
@@ -261,10 +495,17 @@ func (p SearchCallsQueryParams) Paginate(page int, perPage int) {
 
 // Search calls. Reference: https://developer.aircall.io/api-references/#search-calls
 func (service *CallsService) Search(opts *SearchCallsQueryParams) (*CallsResponse, *Response, error) {
+	return service.SearchContext(context.Background(), opts)
+}
+
+// Search calls using the given context. Reference: https://developer.aircall.io/api-references/#search-calls
+func (service *CallsService) SearchContext(ctx context.Context, opts *SearchCallsQueryParams) (*CallsResponse, *Response, error) {
+	ctx = withOperation(ctx, "Call.Search")
+
 	_url := "calls/search"
 
 	responseBody := new(CallsResponse)
-	response, err := service.client.Get(_url, opts, responseBody)
+	response, err := service.client.GetContext(ctx, _url, opts, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -273,6 +514,18 @@ func (service *CallsService) Search(opts *SearchCallsQueryParams) (*CallsRespons
 	return responseBody, response, nil
 }
 
+// Iterate over all searched calls, following pagination. An optional cap on the number
+// of items can be given. Reference: https://developer.aircall.io/api-references/#search-calls
+func (service *CallsService) SearchAll(ctx context.Context, opts *SearchCallsQueryParams, maxItems ...int) iter.Seq2[Call, error] {
+	ctx = withOperation(ctx, "Call.SearchAll")
+
+	_url := "calls/search"
+
+	return paginate(ctx, service.client, _url, opts, maxItems, func(page *CallsResponse) (*GenericResponseMeta, *[]Call) {
+		return page.Meta, page.Calls
+	})
+}
+
 //  ***********************************************************************************
 //  GET CALL
 //  https://developer.aircall.io/api-references/#retrieve-a-call
@@ -280,10 +533,17 @@ func (service *CallsService) Search(opts *SearchCallsQueryParams) (*CallsRespons
 
 // Get a call. Reference: https://developer.aircall.io/api-references/#retrieve-a-call
 func (service *CallsService) Get(callID int) (*CallResponse, *Response, error) {
+	return service.GetContext(context.Background(), callID)
+}
+
+// Get a call using the given context. Reference: https://developer.aircall.io/api-references/#retrieve-a-call
+func (service *CallsService) GetContext(ctx context.Context, callID int) (*CallResponse, *Response, error) {
+	ctx = withOperation(ctx, "Call.Get")
+
 	_url := fmt.Sprintf("calls/%d", callID)
 
 	responseBody := new(CallResponse)
-	response, err := service.client.Get(_url, nil, responseBody)
+	response, err := service.client.GetContext(ctx, _url, nil, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -299,9 +559,16 @@ func (service *CallsService) Get(callID int) (*CallResponse, *Response, error) {
 
 // Transfer a call. Reference: https://developer.aircall.io/api-references/#transfer-a-call
 func (service *CallsService) Transfer(callID int, transferCall *CallTransfer) (*Response, error) {
+	return service.TransferContext(context.Background(), callID, transferCall)
+}
+
+// Transfer a call using the given context. Reference: https://developer.aircall.io/api-references/#transfer-a-call
+func (service *CallsService) TransferContext(ctx context.Context, callID int, transferCall *CallTransfer) (*Response, error) {
+	ctx = withOperation(ctx, "Call.Transfer")
+
 	_url := fmt.Sprintf("calls/%d/transfers", callID)
 
-	return service.client.Post(_url, transferCall, nil)
+	return service.client.PostContext(ctx, _url, transferCall, nil)
 }
 
 //  ***********************************************************************************
@@ -311,13 +578,20 @@ func (service *CallsService) Transfer(callID int, transferCall *CallTransfer) (*
 
 // Comment a call. Reference: https://developer.aircall.io/api-references/#comment-a-call
 func (service *CallsService) Comment(callID int, comment string) (*Response, error) {
+	return service.CommentContext(context.Background(), callID, comment)
+}
+
+// Comment a call using the given context. Reference: https://developer.aircall.io/api-references/#comment-a-call
+func (service *CallsService) CommentContext(ctx context.Context, callID int, comment string) (*Response, error) {
+	ctx = withOperation(ctx, "Call.Comment")
+
 	_url := fmt.Sprintf("calls/%d/comments", callID)
 
 	commentCall := CallComment{
 		Content: comment,
 	}
 
-	return service.client.Post(_url, commentCall, nil)
+	return service.client.PostContext(ctx, _url, commentCall, nil)
 }
 
 //  ***********************************************************************************
@@ -327,13 +601,20 @@ func (service *CallsService) Comment(callID int, comment string) (*Response, err
 
 // Tag a call. Reference: https://developer.aircall.io/api-references/#tag-a-call
 func (service *CallsService) Tag(callID int, tags []int) (*Response, error) {
+	return service.TagContext(context.Background(), callID, tags)
+}
+
+// Tag a call using the given context. Reference: https://developer.aircall.io/api-references/#tag-a-call
+func (service *CallsService) TagContext(ctx context.Context, callID int, tags []int) (*Response, error) {
+	ctx = withOperation(ctx, "Call.Tag")
+
 	_url := fmt.Sprintf("calls/%d/tags", callID)
 
 	tagsCall := CallTags{
 		Tags: tags,
 	}
 
-	return service.client.Post(_url, tagsCall, nil)
+	return service.client.PostContext(ctx, _url, tagsCall, nil)
 }
 
 //  ***********************************************************************************
@@ -343,10 +624,17 @@ func (service *CallsService) Tag(callID int, tags []int) (*Response, error) {
 
 // Archive a call. Reference: https://developer.aircall.io/api-references/#archive-a-call
 func (service *CallsService) Archive(callID int) (*CallResponse, *Response, error) {
+	return service.ArchiveContext(context.Background(), callID)
+}
+
+// Archive a call using the given context. Reference: https://developer.aircall.io/api-references/#archive-a-call
+func (service *CallsService) ArchiveContext(ctx context.Context, callID int) (*CallResponse, *Response, error) {
+	ctx = withOperation(ctx, "Call.Archive")
+
 	_url := fmt.Sprintf("calls/%d/archive", callID)
 
 	responseBody := new(CallResponse)
-	response, err := service.client.Put(_url, nil, responseBody)
+	response, err := service.client.PutContext(ctx, _url, nil, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -362,10 +650,17 @@ func (service *CallsService) Archive(callID int) (*CallResponse, *Response, erro
 
 // Unarchive a call. Reference: https://developer.aircall.io/api-references/#unarchive-a-call
 func (service *CallsService) Unarchive(callID int) (*CallResponse, *Response, error) {
+	return service.UnarchiveContext(context.Background(), callID)
+}
+
+// Unarchive a call using the given context. Reference: https://developer.aircall.io/api-references/#unarchive-a-call
+func (service *CallsService) UnarchiveContext(ctx context.Context, callID int) (*CallResponse, *Response, error) {
+	ctx = withOperation(ctx, "Call.Unarchive")
+
 	_url := fmt.Sprintf("calls/%d/unarchive", callID)
 
 	responseBody := new(CallResponse)
-	response, err := service.client.Put(_url, nil, responseBody)
+	response, err := service.client.PutContext(ctx, _url, nil, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -381,9 +676,16 @@ func (service *CallsService) Unarchive(callID int) (*CallResponse, *Response, er
 
 // Pause recording on a call. Reference: https://developer.aircall.io/api-references/#pause-recording-on-a-call
 func (service *CallsService) PauseRecording(callID int) (*Response, error) {
+	return service.PauseRecordingContext(context.Background(), callID)
+}
+
+// Pause recording on a call using the given context. Reference: https://developer.aircall.io/api-references/#pause-recording-on-a-call
+func (service *CallsService) PauseRecordingContext(ctx context.Context, callID int) (*Response, error) {
+	ctx = withOperation(ctx, "Call.PauseRecording")
+
 	_url := fmt.Sprintf("calls/%d/pause_recording", callID)
 
-	return service.client.Post(_url, nil, nil)
+	return service.client.PostContext(ctx, _url, nil, nil)
 }
 
 //  ***********************************************************************************
@@ -393,9 +695,16 @@ func (service *CallsService) PauseRecording(callID int) (*Response, error) {
 
 // Resume recording on a call. Reference: https://developer.aircall.io/api-references/#resume-recording-on-a-call
 func (service *CallsService) ResumeRecording(callID int) (*Response, error) {
+	return service.ResumeRecordingContext(context.Background(), callID)
+}
+
+// Resume recording on a call using the given context. Reference: https://developer.aircall.io/api-references/#resume-recording-on-a-call
+func (service *CallsService) ResumeRecordingContext(ctx context.Context, callID int) (*Response, error) {
+	ctx = withOperation(ctx, "Call.ResumeRecording")
+
 	_url := fmt.Sprintf("calls/%d/resume_recording", callID)
 
-	return service.client.Post(_url, nil, nil)
+	return service.client.PostContext(ctx, _url, nil, nil)
 }
 
 //  ***********************************************************************************
@@ -405,9 +714,16 @@ func (service *CallsService) ResumeRecording(callID int) (*Response, error) {
 
 // Delete call recording. Reference: https://developer.aircall.io/api-references/#delete-call-recording
 func (service *CallsService) DeleteRecording(callID int) (*Response, error) {
+	return service.DeleteRecordingContext(context.Background(), callID)
+}
+
+// Delete call recording using the given context. Reference: https://developer.aircall.io/api-references/#delete-call-recording
+func (service *CallsService) DeleteRecordingContext(ctx context.Context, callID int) (*Response, error) {
+	ctx = withOperation(ctx, "Call.DeleteRecording")
+
 	_url := fmt.Sprintf("calls/%d/recording", callID)
 
-	return service.client.Delete(_url)
+	return service.client.DeleteContext(ctx, _url)
 }
 
 //  ***********************************************************************************
@@ -417,9 +733,16 @@ func (service *CallsService) DeleteRecording(callID int) (*Response, error) {
 
 // Delete call voicemail. Reference: https://developer.aircall.io/api-references/#delete-call-voicemail
 func (service *CallsService) DeleteVoicemail(callID int) (*Response, error) {
+	return service.DeleteVoicemailContext(context.Background(), callID)
+}
+
+// Delete call voicemail using the given context. Reference: https://developer.aircall.io/api-references/#delete-call-voicemail
+func (service *CallsService) DeleteVoicemailContext(ctx context.Context, callID int) (*Response, error) {
+	ctx = withOperation(ctx, "Call.DeleteVoicemail")
+
 	_url := fmt.Sprintf("calls/%d/voicemail", callID)
 
-	return service.client.Delete(_url)
+	return service.client.DeleteContext(ctx, _url)
 }
 
 //  ***********************************************************************************
@@ -429,7 +752,14 @@ func (service *CallsService) DeleteVoicemail(callID int) (*Response, error) {
 
 // Add insight card to call. Reference: https://developer.aircall.io/api-references/#insight-cards
 func (service *CallsService) AddInsightCard(callID int, insightCard *CallInsightCard) (*Response, error) {
+	return service.AddInsightCardContext(context.Background(), callID, insightCard)
+}
+
+// Add insight card to call using the given context. Reference: https://developer.aircall.io/api-references/#insight-cards
+func (service *CallsService) AddInsightCardContext(ctx context.Context, callID int, insightCard *CallInsightCard) (*Response, error) {
+	ctx = withOperation(ctx, "Call.AddInsightCard")
+
 	_url := fmt.Sprintf("calls/%d/insight_cards", callID)
 
-	return service.client.Post(_url, insightCard, nil)
+	return service.client.PostContext(ctx, _url, insightCard, nil)
 }
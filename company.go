@@ -1,5 +1,7 @@
 package aircall
 
+import "context"
+
 // Company service
 type CompaniesService service
 
@@ -20,10 +22,17 @@ type Company struct {
 
 // Get a company. Reference: https://developer.aircall.io/api-references/#retrieve-company-information
 func (service *CompaniesService) Get() (*CompanyResponse, *Response, error) {
+	return service.GetContext(context.Background())
+}
+
+// Get a company using the given context. Reference: https://developer.aircall.io/api-references/#retrieve-company-information
+func (service *CompaniesService) GetContext(ctx context.Context) (*CompanyResponse, *Response, error) {
+	ctx = withOperation(ctx, "Company.Get")
+
 	_url := "company"
 
 	responseBody := new(CompanyResponse)
-	response, err := service.client.Get(_url, nil, responseBody)
+	response, err := service.client.GetContext(ctx, _url, nil, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -1,6 +1,11 @@
 package aircall
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+)
 
 // Contact service
 type ContactsService service
@@ -36,8 +41,8 @@ type Contact struct {
 	Information  string         `json:"information,omitempty"`
 	IsShared     bool           `json:"is_shared,omitempty"`
 	DirectLink   string         `json:"direct_link,omitempty"`
-	CreatedAt    interface{}    `json:"created_at,omitempty"`
-	UpdatedAt    interface{}    `json:"updated_at,omitempty"`
+	CreatedAt    *Timestamp     `json:"created_at,omitempty"`
+	UpdatedAt    *Timestamp     `json:"updated_at,omitempty"`
 	PhoneNumbers *[]ContactInfo `json:"phone_numbers,omitempty"`
 	Emails       *[]ContactInfo `json:"emails,omitempty"`
 }
@@ -87,14 +92,24 @@ func (p ListContactsQueryParams) To(value string) {
 	p.to(value)
 }
 
+// Set 'from' as a time for 'List' method.
+func (p ListContactsQueryParams) FromTime(value time.Time) {
+	p.fromTime(value)
+}
+
+// Set 'to' as a time for 'List' method.
+func (p ListContactsQueryParams) ToTime(value time.Time) {
+	p.toTime(value)
+}
+
 // Set 'order' for for 'List' method.
-func (p ListContactsQueryParams) Order(value string) {
-	p.order(value)
+func (p ListContactsQueryParams) Order(value SortOrder) {
+	p.order(string(value))
 }
 
 // Set 'order_by' by for for 'List' method.
-func (p ListContactsQueryParams) OrderBy(value string) {
-	p.orderBy(value)
+func (p ListContactsQueryParams) OrderBy(value ContactOrderBy) {
+	p.orderBy(string(value))
 }
 
 // Set 'page' and 'per_page' for for 'List' method.
@@ -104,10 +119,17 @@ func (p ListContactsQueryParams) Paginate(page int, perPage int) {
 
 // List contacts. Reference: https://developer.aircall.io/api-references/#list-all-contacts
 func (service *ContactsService) List(opts *ListContactsQueryParams) (*ContactsResponse, *Response, error) {
+	return service.ListContext(context.Background(), opts)
+}
+
+// List contacts using the given context. Reference: https://developer.aircall.io/api-references/#list-all-contacts
+func (service *ContactsService) ListContext(ctx context.Context, opts *ListContactsQueryParams) (*ContactsResponse, *Response, error) {
+	ctx = withOperation(ctx, "Contact.List")
+
 	_url := "contacts"
 
 	responseBody := new(ContactsResponse)
-	response, err := service.client.Get(_url, opts, responseBody)
+	response, err := service.client.GetContext(ctx, _url, opts, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -116,6 +138,41 @@ func (service *ContactsService) List(opts *ListContactsQueryParams) (*ContactsRe
 	return responseBody, response, nil
 }
 
+// Iterate over all contacts, following pagination. An optional cap on the number
+// of items can be given. Reference: https://developer.aircall.io/api-references/#list-all-contacts
+func (service *ContactsService) All(ctx context.Context, opts *ListContactsQueryParams, maxItems ...int) iter.Seq2[Contact, error] {
+	ctx = withOperation(ctx, "Contact.All")
+
+	_url := "contacts"
+
+	return paginate(ctx, service.client, _url, opts, maxItems, func(page *ContactsResponse) (*GenericResponseMeta, *[]Contact) {
+		return page.Meta, page.Contacts
+	})
+}
+
+// Iterate over all contacts created between 'from' and 'to'. The range is split in
+// smaller windows when it holds more items than Aircall paginates through
+// (10,000). Reference: https://developer.aircall.io/api-references/#list-all-contacts
+func (service *ContactsService) AllBetween(ctx context.Context, opts *ListContactsQueryParams, from time.Time, to time.Time) iter.Seq2[Contact, error] {
+	ctx = withOperation(ctx, "Contact.AllBetween")
+
+	_url := "contacts"
+
+	var values QueryValues
+
+	if opts != nil {
+		values = opts.QueryValues
+	}
+
+	page := func(page *ContactsResponse) (*GenericResponseMeta, *[]Contact) {
+		return page.Meta, page.Contacts
+	}
+
+	return paginateWindows(ctx, service.client, _url, values, from, to, page, func(item Contact) int {
+		return item.ID
+	})
+}
+
 //  ***********************************************************************************
 //  SEARCH CONTACTS
 //  https://developer.aircall.io/api-references/#search-contacts
@@ -138,14 +195,24 @@ func (p SearchContactsQueryParams) To(value string) {
 	p.to(value)
 }
 
+// Set 'from' as a time for 'Search' method.
+func (p SearchContactsQueryParams) FromTime(value time.Time) {
+	p.fromTime(value)
+}
+
+// Set 'to' as a time for 'Search' method.
+func (p SearchContactsQueryParams) ToTime(value time.Time) {
+	p.toTime(value)
+}
+
 // Set 'order' for for 'Search' method.
-func (p SearchContactsQueryParams) Order(value string) {
-	p.order(value)
+func (p SearchContactsQueryParams) Order(value SortOrder) {
+	p.order(string(value))
 }
 
 // Set 'order_by' by for for 'Search' method.
-func (p SearchContactsQueryParams) OrderBy(value string) {
-	p.orderBy(value)
+func (p SearchContactsQueryParams) OrderBy(value ContactOrderBy) {
+	p.orderBy(string(value))
 }
 
 // Set 'phone_number' for for 'Search' method.
@@ -165,10 +232,17 @@ func (p SearchContactsQueryParams) Paginate(page int, perPage int) {
 
 // Search contacts. Reference: https://developer.aircall.io/api-references/#search-contacts
 func (service *ContactsService) Search(opts *SearchContactsQueryParams) (*ContactsResponse, *Response, error) {
+	return service.SearchContext(context.Background(), opts)
+}
+
+// Search contacts using the given context. Reference: https://developer.aircall.io/api-references/#search-contacts
+func (service *ContactsService) SearchContext(ctx context.Context, opts *SearchContactsQueryParams) (*ContactsResponse, *Response, error) {
+	ctx = withOperation(ctx, "Contact.Search")
+
 	_url := "contacts/search"
 
 	responseBody := new(ContactsResponse)
-	response, err := service.client.Get(_url, opts, responseBody)
+	response, err := service.client.GetContext(ctx, _url, opts, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -177,6 +251,18 @@ func (service *ContactsService) Search(opts *SearchContactsQueryParams) (*Contac
 	return responseBody, response, nil
 }
 
+// Iterate over all searched contacts, following pagination. An optional cap on the number
+// of items can be given. Reference: https://developer.aircall.io/api-references/#search-contacts
+func (service *ContactsService) SearchAll(ctx context.Context, opts *SearchContactsQueryParams, maxItems ...int) iter.Seq2[Contact, error] {
+	ctx = withOperation(ctx, "Contact.SearchAll")
+
+	_url := "contacts/search"
+
+	return paginate(ctx, service.client, _url, opts, maxItems, func(page *ContactsResponse) (*GenericResponseMeta, *[]Contact) {
+		return page.Meta, page.Contacts
+	})
+}
+
 //  ***********************************************************************************
 //  GET CONTACT
 //  https://developer.aircall.io/api-references/#retrieve-a-contact
@@ -184,10 +270,17 @@ func (service *ContactsService) Search(opts *SearchContactsQueryParams) (*Contac
 
 // Get a contact. Reference: https://developer.aircall.io/api-references/#retrieve-a-contact
 func (service *ContactsService) Get(contactID int) (*ContactResponse, *Response, error) {
+	return service.GetContext(context.Background(), contactID)
+}
+
+// Get a contact using the given context. Reference: https://developer.aircall.io/api-references/#retrieve-a-contact
+func (service *ContactsService) GetContext(ctx context.Context, contactID int) (*ContactResponse, *Response, error) {
+	ctx = withOperation(ctx, "Contact.Get")
+
 	_url := fmt.Sprintf("contacts/%d", contactID)
 
 	responseBody := new(ContactResponse)
-	response, err := service.client.Get(_url, nil, responseBody)
+	response, err := service.client.GetContext(ctx, _url, nil, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -203,10 +296,17 @@ func (service *ContactsService) Get(contactID int) (*ContactResponse, *Response,
 
 // Create a contact. Reference: https://developer.aircall.io/api-references/#create-a-contact
 func (service *ContactsService) Create(contact *CreateUpdateContact) (*ContactResponse, *Response, error) {
+	return service.CreateContext(context.Background(), contact)
+}
+
+// Create a contact using the given context. Reference: https://developer.aircall.io/api-references/#create-a-contact
+func (service *ContactsService) CreateContext(ctx context.Context, contact *CreateUpdateContact) (*ContactResponse, *Response, error) {
+	ctx = withOperation(ctx, "Contact.Create")
+
 	_url := "contacts"
 
 	responseBody := new(ContactResponse)
-	response, err := service.client.Post(_url, contact, responseBody)
+	response, err := service.client.PostContext(ctx, _url, contact, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -222,10 +322,17 @@ func (service *ContactsService) Create(contact *CreateUpdateContact) (*ContactRe
 
 // Update a contact. Reference: https://developer.aircall.io/api-references/#update-a-contact
 func (service *ContactsService) Update(contactID int, contact *CreateUpdateContact) (*CreateUpdateContact, *Response, error) {
+	return service.UpdateContext(context.Background(), contactID, contact)
+}
+
+// Update a contact using the given context. Reference: https://developer.aircall.io/api-references/#update-a-contact
+func (service *ContactsService) UpdateContext(ctx context.Context, contactID int, contact *CreateUpdateContact) (*CreateUpdateContact, *Response, error) {
+	ctx = withOperation(ctx, "Contact.Update")
+
 	_url := fmt.Sprintf("contacts/%d", contactID)
 
 	responseBody := new(CreateUpdateContact)
-	response, err := service.client.Post(_url, contact, responseBody)
+	response, err := service.client.PostContext(ctx, _url, contact, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -241,9 +348,16 @@ func (service *ContactsService) Update(contactID int, contact *CreateUpdateConta
 
 // Delete a contact. Reference: https://developer.aircall.io/api-references/#delete-a-contact
 func (service *ContactsService) Delete(contactID int) (*Response, error) {
+	return service.DeleteContext(context.Background(), contactID)
+}
+
+// Delete a contact using the given context. Reference: https://developer.aircall.io/api-references/#delete-a-contact
+func (service *ContactsService) DeleteContext(ctx context.Context, contactID int) (*Response, error) {
+	ctx = withOperation(ctx, "Contact.Delete")
+
 	_url := fmt.Sprintf("contacts/%d", contactID)
 
-	return service.client.Delete(_url)
+	return service.client.DeleteContext(ctx, _url)
 }
 
 //  ***********************************************************************************
@@ -253,10 +367,17 @@ func (service *ContactsService) Delete(contactID int) (*Response, error) {
 
 // Add a phone number to a contact. Reference: https://developer.aircall.io/api-references/#add-phone-number-to-a-contact
 func (service *ContactsService) AddNumber(contactID int, number *ContactInfo) (*ContactInfoResponse, *Response, error) {
+	return service.AddNumberContext(context.Background(), contactID, number)
+}
+
+// Add a phone number to a contact using the given context. Reference: https://developer.aircall.io/api-references/#add-phone-number-to-a-contact
+func (service *ContactsService) AddNumberContext(ctx context.Context, contactID int, number *ContactInfo) (*ContactInfoResponse, *Response, error) {
+	ctx = withOperation(ctx, "Contact.AddNumber")
+
 	_url := fmt.Sprintf("contacts/%d/phone_details", contactID)
 
 	responseBody := new(ContactInfoResponse)
-	response, err := service.client.Post(_url, number, responseBody)
+	response, err := service.client.PostContext(ctx, _url, number, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -272,10 +393,17 @@ func (service *ContactsService) AddNumber(contactID int, number *ContactInfo) (*
 
 // Update a phone number to a contact. Reference: https://developer.aircall.io/api-references/#update-phone-number-to-a-contact
 func (service *ContactsService) UpdateNumber(contactID int, numberID int, number *ContactInfo) (*ContactInfoResponse, *Response, error) {
+	return service.UpdateNumberContext(context.Background(), contactID, numberID, number)
+}
+
+// Update a phone number to a contact using the given context. Reference: https://developer.aircall.io/api-references/#update-phone-number-to-a-contact
+func (service *ContactsService) UpdateNumberContext(ctx context.Context, contactID int, numberID int, number *ContactInfo) (*ContactInfoResponse, *Response, error) {
+	ctx = withOperation(ctx, "Contact.UpdateNumber")
+
 	_url := fmt.Sprintf("contacts/%d/phone_details/%d", contactID, numberID)
 
 	responseBody := new(ContactInfoResponse)
-	response, err := service.client.Put(_url, number, responseBody)
+	response, err := service.client.PutContext(ctx, _url, number, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -291,9 +419,16 @@ func (service *ContactsService) UpdateNumber(contactID int, numberID int, number
 
 // Delete a phone number from a contact. Reference: https://developer.aircall.io/api-references/#delete-phone-number-from-a-contact
 func (service *ContactsService) DeleteNumber(contactID int, numberID int) (*Response, error) {
+	return service.DeleteNumberContext(context.Background(), contactID, numberID)
+}
+
+// Delete a phone number from a contact using the given context. Reference: https://developer.aircall.io/api-references/#delete-phone-number-from-a-contact
+func (service *ContactsService) DeleteNumberContext(ctx context.Context, contactID int, numberID int) (*Response, error) {
+	ctx = withOperation(ctx, "Contact.DeleteNumber")
+
 	_url := fmt.Sprintf("contacts/%d/phone_details/%d", contactID, numberID)
 
-	return service.client.Delete(_url)
+	return service.client.DeleteContext(ctx, _url)
 }
 
 //  ***********************************************************************************
@@ -303,10 +438,17 @@ func (service *ContactsService) DeleteNumber(contactID int, numberID int) (*Resp
 
 // Add an email to a contact. Reference: https://developer.aircall.io/api-references/#add-email-to-a-contact
 func (service *ContactsService) AddEmail(contactID int, email *ContactInfo) (*ContactInfoResponse, *Response, error) {
+	return service.AddEmailContext(context.Background(), contactID, email)
+}
+
+// Add an email to a contact using the given context. Reference: https://developer.aircall.io/api-references/#add-email-to-a-contact
+func (service *ContactsService) AddEmailContext(ctx context.Context, contactID int, email *ContactInfo) (*ContactInfoResponse, *Response, error) {
+	ctx = withOperation(ctx, "Contact.AddEmail")
+
 	_url := fmt.Sprintf("contacts/%d/email_details", contactID)
 
 	responseBody := new(ContactInfoResponse)
-	response, err := service.client.Post(_url, email, responseBody)
+	response, err := service.client.PostContext(ctx, _url, email, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -322,10 +464,17 @@ func (service *ContactsService) AddEmail(contactID int, email *ContactInfo) (*Co
 
 // Update a phone number to a contact. Reference: https://developer.aircall.io/api-references/#update-phone-number-to-a-contact
 func (service *ContactsService) UpdateEmail(contactID int, emailID int, email *ContactInfo) (*ContactInfoResponse, *Response, error) {
+	return service.UpdateEmailContext(context.Background(), contactID, emailID, email)
+}
+
+// Update a phone number to a contact using the given context. Reference: https://developer.aircall.io/api-references/#update-phone-number-to-a-contact
+func (service *ContactsService) UpdateEmailContext(ctx context.Context, contactID int, emailID int, email *ContactInfo) (*ContactInfoResponse, *Response, error) {
+	ctx = withOperation(ctx, "Contact.UpdateEmail")
+
 	_url := fmt.Sprintf("contacts/%d/email_details/%d", contactID, emailID)
 
 	responseBody := new(ContactInfoResponse)
-	response, err := service.client.Put(_url, email, responseBody)
+	response, err := service.client.PutContext(ctx, _url, email, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -341,7 +490,14 @@ func (service *ContactsService) UpdateEmail(contactID int, emailID int, email *C
 
 // Delete a phone number from a contact. Reference: https://developer.aircall.io/api-references/#delete-phone-number-from-a-contact
 func (service *ContactsService) DeleteEmail(contactID int, emailID int) (*Response, error) {
+	return service.DeleteEmailContext(context.Background(), contactID, emailID)
+}
+
+// Delete a phone number from a contact using the given context. Reference: https://developer.aircall.io/api-references/#delete-phone-number-from-a-contact
+func (service *ContactsService) DeleteEmailContext(ctx context.Context, contactID int, emailID int) (*Response, error) {
+	ctx = withOperation(ctx, "Contact.DeleteEmail")
+
 	_url := fmt.Sprintf("contacts/%d/email_details/%d", contactID, emailID)
 
-	return service.client.Delete(_url)
+	return service.client.DeleteContext(ctx, _url)
 }
@@ -1,6 +1,9 @@
 package aircall
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // Conversation Intelligence service
 type ConversationIntelligenceService service
@@ -24,7 +27,7 @@ type ConversationIntelligenceSummaryResponse struct {
 type ConversationIntelligenceTranscription struct {
 	ID            int                                           `json:"id,omitempty"`
 	CallID        int                                           `json:"call_id,omitempty"`
-	CallCreatedAt interface{}                                   `json:"call_created_at,omitempty"`
+	CallCreatedAt *Timestamp                                    `json:"call_created_at,omitempty"`
 	Type          string                                        `json:"type,omitempty"`
 	Content       *ConversationIntelligenceTranscriptionContent `json:"content,omitempty"`
 }
@@ -58,17 +61,24 @@ type ConversationIntelligenceParticipants struct {
 }
 
 type ConversationIntelligenceTopic struct {
-	ID        int         `json:"id,omitempty"`
-	CallID    int         `json:"call_id,omitempty"`
-	CreatedAt interface{} `json:"created_at,omitempty"`
-	Content   []string    `json:"content,omitempty"`
+	ID        int        `json:"id,omitempty"`
+	CallID    int        `json:"call_id,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+	Content   []string   `json:"content,omitempty"`
 }
 
 type ConversationIntelligenceSummary struct {
-	ID        int         `json:"id,omitempty"`
-	CallID    int         `json:"call_id,omitempty"`
-	CreatedAt interface{} `json:"created_at,omitempty"`
-	Content   string      `json:"content,omitempty"`
+	ID        int        `json:"id,omitempty"`
+	CallID    int        `json:"call_id,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+	Content   string     `json:"content,omitempty"`
+}
+
+type ConversationIntelligenceActionItem struct {
+	ID        int        `json:"id,omitempty"`
+	CallID    int        `json:"call_id,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+	Content   string     `json:"content,omitempty"`
 }
 
 //  ***********************************************************************************
@@ -78,10 +88,17 @@ type ConversationIntelligenceSummary struct {
 
 // Get a call transcription. Reference: https://developer.aircall.io/api-references/#retrieve-a-transcription
 func (service *ConversationIntelligenceService) GetTranscription(callID int) (*ConversationIntelligenceTranscriptionResponse, *Response, error) {
+	return service.GetTranscriptionContext(context.Background(), callID)
+}
+
+// Get a call transcription using the given context. Reference: https://developer.aircall.io/api-references/#retrieve-a-transcription
+func (service *ConversationIntelligenceService) GetTranscriptionContext(ctx context.Context, callID int) (*ConversationIntelligenceTranscriptionResponse, *Response, error) {
+	ctx = withOperation(ctx, "ConversationIntelligence.GetTranscription")
+
 	_url := fmt.Sprintf("calls/%d/transcription", callID)
 
 	responseBody := new(ConversationIntelligenceTranscriptionResponse)
-	response, err := service.client.Get(_url, nil, responseBody)
+	response, err := service.client.GetContext(ctx, _url, nil, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -97,10 +114,17 @@ func (service *ConversationIntelligenceService) GetTranscription(callID int) (*C
 
 // Get a call sentiment. Reference: https://developer.aircall.io/api-references/#retrieve-sentiments
 func (service *ConversationIntelligenceService) GetSentiment(callID int) (*ConversationIntelligenceSentimentResponse, *Response, error) {
+	return service.GetSentimentContext(context.Background(), callID)
+}
+
+// Get a call sentiment using the given context. Reference: https://developer.aircall.io/api-references/#retrieve-sentiments
+func (service *ConversationIntelligenceService) GetSentimentContext(ctx context.Context, callID int) (*ConversationIntelligenceSentimentResponse, *Response, error) {
+	ctx = withOperation(ctx, "ConversationIntelligence.GetSentiment")
+
 	_url := fmt.Sprintf("calls/%d/sentiments", callID)
 
 	responseBody := new(ConversationIntelligenceSentimentResponse)
-	response, err := service.client.Get(_url, nil, responseBody)
+	response, err := service.client.GetContext(ctx, _url, nil, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -116,10 +140,17 @@ func (service *ConversationIntelligenceService) GetSentiment(callID int) (*Conve
 
 // Get a call topics. Reference: https://developer.aircall.io/api-references/#retrieve-topics
 func (service *ConversationIntelligenceService) GetTopics(callID int) (*ConversationIntelligenceTopicResponse, *Response, error) {
+	return service.GetTopicsContext(context.Background(), callID)
+}
+
+// Get a call topics using the given context. Reference: https://developer.aircall.io/api-references/#retrieve-topics
+func (service *ConversationIntelligenceService) GetTopicsContext(ctx context.Context, callID int) (*ConversationIntelligenceTopicResponse, *Response, error) {
+	ctx = withOperation(ctx, "ConversationIntelligence.GetTopics")
+
 	_url := fmt.Sprintf("calls/%d/topics", callID)
 
 	responseBody := new(ConversationIntelligenceTopicResponse)
-	response, err := service.client.Get(_url, nil, responseBody)
+	response, err := service.client.GetContext(ctx, _url, nil, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -135,10 +166,17 @@ func (service *ConversationIntelligenceService) GetTopics(callID int) (*Conversa
 
 // Get a call summary. Reference: https://developer.aircall.io/api-references/#retrieve-a-summary
 func (service *ConversationIntelligenceService) GetSummary(callID int) (*ConversationIntelligenceSummaryResponse, *Response, error) {
+	return service.GetSummaryContext(context.Background(), callID)
+}
+
+// Get a call summary using the given context. Reference: https://developer.aircall.io/api-references/#retrieve-a-summary
+func (service *ConversationIntelligenceService) GetSummaryContext(ctx context.Context, callID int) (*ConversationIntelligenceSummaryResponse, *Response, error) {
+	ctx = withOperation(ctx, "ConversationIntelligence.GetSummary")
+
 	_url := fmt.Sprintf("calls/%d/summary", callID)
 
 	responseBody := new(ConversationIntelligenceSummaryResponse)
-	response, err := service.client.Get(_url, nil, responseBody)
+	response, err := service.client.GetContext(ctx, _url, nil, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -0,0 +1,183 @@
+package aircall
+
+import "encoding/json"
+
+// Event is a decoded inbound webhook. Use a type switch to access the typed
+// payload (eg. *CallEvent, *ContactEvent, *SummaryEvent).
+type Event interface {
+	// Envelope returns the inbound webhook the event was decoded from
+	Envelope() *InboundWebhook
+}
+
+type CallEvent struct {
+	*InboundWebhook
+	Call *Call
+}
+
+type UserEvent struct {
+	*InboundWebhook
+	User *User
+}
+
+type ContactEvent struct {
+	*InboundWebhook
+	Contact *Contact
+}
+
+type NumberEvent struct {
+	*InboundWebhook
+	Number *Number
+}
+
+type MessageEvent struct {
+	*InboundWebhook
+	Message *Message
+}
+
+type IntegrationEvent struct {
+	*InboundWebhook
+	Integration *Integration
+}
+
+type TranscriptionEvent struct {
+	*InboundWebhook
+	Transcription *ConversationIntelligenceTranscription
+}
+
+type SentimentEvent struct {
+	*InboundWebhook
+	Sentiment *ConversationIntelligenceSentiment
+}
+
+type TopicEvent struct {
+	*InboundWebhook
+	Topic *ConversationIntelligenceTopic
+}
+
+type SummaryEvent struct {
+	*InboundWebhook
+	Summary *ConversationIntelligenceSummary
+}
+
+type ActionItemEvent struct {
+	*InboundWebhook
+	ActionItem *ConversationIntelligenceActionItem
+}
+
+// UnknownEvent is returned for resources or events this library does not
+// know about. Its raw payload is available in 'Data'.
+type UnknownEvent struct {
+	*InboundWebhook
+}
+
+func (w *InboundWebhook) Envelope() *InboundWebhook {
+	return w
+}
+
+// DecodeWebhook parses a webhook body and decodes its payload
+func DecodeWebhook(data []byte) (Event, error) {
+	webhook := new(InboundWebhook)
+
+	if err := json.Unmarshal(data, webhook); err != nil {
+		return nil, err
+	}
+
+	return webhook.Decode()
+}
+
+// Decode returns the typed event matching the webhook resource and event
+func (w *InboundWebhook) Decode() (Event, error) {
+	switch w.Resource {
+	case WebhookResourceCall:
+		call, err := w.GetCallData()
+		if err != nil {
+			return nil, err
+		}
+
+		return &CallEvent{InboundWebhook: w, Call: call}, nil
+
+	case WebhookResourceUser:
+		user, err := w.GetUserData()
+		if err != nil {
+			return nil, err
+		}
+
+		return &UserEvent{InboundWebhook: w, User: user}, nil
+
+	case WebhookResourceContact:
+		contact, err := w.GetContactData()
+		if err != nil {
+			return nil, err
+		}
+
+		return &ContactEvent{InboundWebhook: w, Contact: contact}, nil
+
+	case WebhookResourceNumber:
+		number, err := w.GetNumberData()
+		if err != nil {
+			return nil, err
+		}
+
+		return &NumberEvent{InboundWebhook: w, Number: number}, nil
+
+	case WebhookResourceMessage:
+		message, err := w.GetMessageData()
+		if err != nil {
+			return nil, err
+		}
+
+		return &MessageEvent{InboundWebhook: w, Message: message}, nil
+
+	case WebhookResourceIntegration:
+		integration, err := w.GetIntegrationData()
+		if err != nil {
+			return nil, err
+		}
+
+		return &IntegrationEvent{InboundWebhook: w, Integration: integration}, nil
+	}
+
+	switch w.conversationIntelligenceKind() {
+	case "transcription":
+		transcription, err := w.GetConversationIntelligenceTranscriptionData()
+		if err != nil {
+			return nil, err
+		}
+
+		return &TranscriptionEvent{InboundWebhook: w, Transcription: transcription}, nil
+
+	case "sentiment":
+		sentiment, err := w.GetConversationIntelligenceSentimentData()
+		if err != nil {
+			return nil, err
+		}
+
+		return &SentimentEvent{InboundWebhook: w, Sentiment: sentiment}, nil
+
+	case "topic":
+		topic, err := w.GetConversationIntelligenceTopicData()
+		if err != nil {
+			return nil, err
+		}
+
+		return &TopicEvent{InboundWebhook: w, Topic: topic}, nil
+
+	case "summary":
+		summary, err := w.GetConversationIntelligenceSummaryData()
+		if err != nil {
+			return nil, err
+		}
+
+		return &SummaryEvent{InboundWebhook: w, Summary: summary}, nil
+
+	case "action_item":
+		actionItem, err := w.GetConversationIntelligenceActionItemData()
+		if err != nil {
+			return nil, err
+		}
+
+		return &ActionItemEvent{InboundWebhook: w, ActionItem: actionItem}, nil
+	}
+
+	return &UnknownEvent{InboundWebhook: w}, nil
+}
@@ -0,0 +1,77 @@
+package aircall
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeWebhookFixtures(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    Event
+	}{
+		{"call.created.json", &CallEvent{}},
+		{"user.connected.json", &UserEvent{}},
+		{"contact.created.json", &ContactEvent{}},
+		{"number.opened.json", &NumberEvent{}},
+		{"message.received.json", &MessageEvent{}},
+		{"integration.created.json", &IntegrationEvent{}},
+		{"transcription.created.json", &TranscriptionEvent{}},
+		{"sentiment.created.json", &SentimentEvent{}},
+		{"topic.created.json", &TopicEvent{}},
+		{"summary.created.json", &SummaryEvent{}},
+		{"action_item.created.json", &ActionItemEvent{}},
+		{"unknown.json", &UnknownEvent{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join("testdata", "webhooks", test.fixture))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			event, err := DecodeWebhook(data)
+			if err != nil {
+				t.Fatalf("DecodeWebhook() error = %v", err)
+			}
+
+			if reflect.TypeOf(event) != reflect.TypeOf(test.want) {
+				t.Fatalf("DecodeWebhook() = %T, want %T", event, test.want)
+			}
+
+			if event.Envelope().Token != "webhook-token" {
+				t.Errorf("Envelope().Token = %q, want %q", event.Envelope().Token, "webhook-token")
+			}
+
+			// The typed payload is decoded, not left empty
+			payload := reflect.ValueOf(event).Elem()
+
+			for i := 0; i < payload.NumField(); i++ {
+				if field := payload.Field(i); field.Kind() == reflect.Pointer && field.IsNil() {
+					t.Errorf("%T.%s is nil", event, payload.Type().Field(i).Name)
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeWebhookActionItem(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "webhooks", "action_item.created.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	event, err := DecodeWebhook(data)
+	if err != nil {
+		t.Fatalf("DecodeWebhook() error = %v", err)
+	}
+
+	actionItem := event.(*ActionItemEvent).ActionItem
+
+	if actionItem.CallID != 812 || actionItem.Content != "Send the renewal quote." {
+		t.Errorf("ActionItem = %+v", actionItem)
+	}
+}
@@ -1,14 +1,17 @@
 package aircall
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // Dialer Campaign service
 type DialerCampaignsService service
 
 type DialerCampaign struct {
-	ID        int         `json:"id,omitempty"`
-	NumberID  string      `json:"number_id,omitempty"`
-	CreatedAt interface{} `json:"created_at,omitempty"`
+	ID        int        `json:"id,omitempty"`
+	NumberID  string     `json:"number_id,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
 }
 
 type CreateUpdateDialerCampaign struct {
@@ -20,10 +23,10 @@ type DialerCampaignPhoneNumberResponse struct {
 }
 
 type DialerCampaignPhoneNumber struct {
-	ID        int         `json:"id,omitempty"`
-	Number    string      `json:"number,omitempty"`
-	Called    bool        `json:"called,omitempty"`
-	CreatedAt interface{} `json:"created_at,omitempty"`
+	ID        int        `json:"id,omitempty"`
+	Number    string     `json:"number,omitempty"`
+	Called    bool       `json:"called,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
 }
 
 //  ***********************************************************************************
@@ -33,10 +36,17 @@ type DialerCampaignPhoneNumber struct {
 
 // Get a dialer campaign. Reference: https://developer.aircall.io/api-references/#retrieve-a-dialer-campaign
 func (service *DialerCampaignsService) Get(userID int) (*DialerCampaign, *Response, error) {
+	return service.GetContext(context.Background(), userID)
+}
+
+// Get a dialer campaign using the given context. Reference: https://developer.aircall.io/api-references/#retrieve-a-dialer-campaign
+func (service *DialerCampaignsService) GetContext(ctx context.Context, userID int) (*DialerCampaign, *Response, error) {
+	ctx = withOperation(ctx, "DialerCampaign.Get")
+
 	_url := fmt.Sprintf("users/%d/dialer_campaign", userID)
 
 	responseBody := new(DialerCampaign)
-	response, err := service.client.Get(_url, nil, responseBody)
+	response, err := service.client.GetContext(ctx, _url, nil, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -52,9 +62,16 @@ func (service *DialerCampaignsService) Get(userID int) (*DialerCampaign, *Respon
 
 // Create a dialer campaign. Reference: https://developer.aircall.io/api-references/#create-a-dialer-campaign
 func (service *DialerCampaignsService) Create(userID int, dialerCampaign *CreateUpdateDialerCampaign) (*Response, error) {
+	return service.CreateContext(context.Background(), userID, dialerCampaign)
+}
+
+// Create a dialer campaign using the given context. Reference: https://developer.aircall.io/api-references/#create-a-dialer-campaign
+func (service *DialerCampaignsService) CreateContext(ctx context.Context, userID int, dialerCampaign *CreateUpdateDialerCampaign) (*Response, error) {
+	ctx = withOperation(ctx, "DialerCampaign.Create")
+
 	_url := fmt.Sprintf("users/%d/dialer_campaign", userID)
 
-	return service.client.Post(_url, dialerCampaign, nil)
+	return service.client.PostContext(ctx, _url, dialerCampaign, nil)
 }
 
 //  ***********************************************************************************
@@ -64,9 +81,16 @@ func (service *DialerCampaignsService) Create(userID int, dialerCampaign *Create
 
 // Delete a dialer campaign. Reference: https://developer.aircall.io/api-references/#delete-a-dialer-campaign
 func (service *DialerCampaignsService) Delete(userId int) (*Response, error) {
+	return service.DeleteContext(context.Background(), userId)
+}
+
+// Delete a dialer campaign using the given context. Reference: https://developer.aircall.io/api-references/#delete-a-dialer-campaign
+func (service *DialerCampaignsService) DeleteContext(ctx context.Context, userId int) (*Response, error) {
+	ctx = withOperation(ctx, "DialerCampaign.Delete")
+
 	_url := fmt.Sprintf("users/%d/dialer_campaign", userId)
 
-	return service.client.Delete(_url)
+	return service.client.DeleteContext(ctx, _url)
 }
 
 //  ***********************************************************************************
@@ -76,10 +100,17 @@ func (service *DialerCampaignsService) Delete(userId int) (*Response, error) {
 
 // List dialer campaign phone numbers. Reference: https://developer.aircall.io/api-references/#retrieve-phone-numbers
 func (service *DialerCampaignsService) ListNumbers(userId int) (*DialerCampaignPhoneNumberResponse, *Response, error) {
+	return service.ListNumbersContext(context.Background(), userId)
+}
+
+// List dialer campaign phone numbers using the given context. Reference: https://developer.aircall.io/api-references/#retrieve-phone-numbers
+func (service *DialerCampaignsService) ListNumbersContext(ctx context.Context, userId int) (*DialerCampaignPhoneNumberResponse, *Response, error) {
+	ctx = withOperation(ctx, "DialerCampaign.ListNumbers")
+
 	_url := fmt.Sprintf("users/%d/dialer_campaign/phone_numbers", userId)
 
 	responseBody := new(DialerCampaignPhoneNumberResponse)
-	response, err := service.client.Get(_url, nil, responseBody)
+	response, err := service.client.GetContext(ctx, _url, nil, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -95,9 +126,16 @@ func (service *DialerCampaignsService) ListNumbers(userId int) (*DialerCampaignP
 
 // Add dialer campaign phone numbers. Reference: https://developer.aircall.io/api-references/#add-phone-numbers
 func (service *DialerCampaignsService) AddNumbers(userId int, phoneNumbers *CreateUpdateDialerCampaign) (*Response, error) {
+	return service.AddNumbersContext(context.Background(), userId, phoneNumbers)
+}
+
+// Add dialer campaign phone numbers using the given context. Reference: https://developer.aircall.io/api-references/#add-phone-numbers
+func (service *DialerCampaignsService) AddNumbersContext(ctx context.Context, userId int, phoneNumbers *CreateUpdateDialerCampaign) (*Response, error) {
+	ctx = withOperation(ctx, "DialerCampaign.AddNumbers")
+
 	_url := fmt.Sprintf("users/%d/dialer_campaign/phone_numbers", userId)
 
-	return service.client.Post(_url, phoneNumbers, nil)
+	return service.client.PostContext(ctx, _url, phoneNumbers, nil)
 }
 
 //  ***********************************************************************************
@@ -107,7 +145,14 @@ func (service *DialerCampaignsService) AddNumbers(userId int, phoneNumbers *Crea
 
 // Delete dialer campaign phone number. Reference: https://developer.aircall.io/api-references/#delete-a-phone-number
 func (service *DialerCampaignsService) DeleteNumber(userId int, phoneNumberId int) (*Response, error) {
+	return service.DeleteNumberContext(context.Background(), userId, phoneNumberId)
+}
+
+// Delete dialer campaign phone number using the given context. Reference: https://developer.aircall.io/api-references/#delete-a-phone-number
+func (service *DialerCampaignsService) DeleteNumberContext(ctx context.Context, userId int, phoneNumberId int) (*Response, error) {
+	ctx = withOperation(ctx, "DialerCampaign.DeleteNumber")
+
 	_url := fmt.Sprintf("users/%d/dialer_campaign/phone_numbers/%d", userId, phoneNumberId)
 
-	return service.client.Delete(_url)
+	return service.client.DeleteContext(ctx, _url)
 }
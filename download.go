@@ -0,0 +1,308 @@
+package aircall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Times an expired media URL is refreshed during a download
+	downloadMaxRefreshes = 2
+)
+
+var (
+	ErrMediaUnavailable = errors.New("aircall: call has no media to download")
+	ErrMediaContentType = errors.New("aircall: unexpected media content type")
+	ErrMediaIncomplete  = errors.New("aircall: media download incomplete")
+
+	// Media URL expired and was refreshed: retried right away, without
+	// counting as a transfer retry
+	errMediaURLRefreshed = errors.New("aircall: media URL refreshed")
+)
+
+// Content types accepted for call recordings and voicemails. Storage may
+// serve media as generic binary.
+var mediaContentTypes = []string{"audio/", "video/", "application/octet-stream", "binary/octet-stream"}
+
+// MediaContentTypeError is returned when a media URL serves something other
+// than audio (eg. an HTML or XML error page). It matches ErrMediaContentType.
+type MediaContentTypeError struct {
+	ContentType string
+}
+
+func (e *MediaContentTypeError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrMediaContentType, e.ContentType)
+}
+
+func (e *MediaContentTypeError) Is(target error) bool {
+	return target == ErrMediaContentType
+}
+
+// mediaDownload streams a call media, resuming where it stopped
+type mediaDownload struct {
+	service *CallsService
+	callID  int
+	media   func(call *Call) string
+
+	url       string
+	written   int64
+	total     int64
+	refreshes int
+}
+
+//  ***********************************************************************************
+//  DOWNLOAD CALL RECORDING
+//  ***********************************************************************************
+
+// Download the call recording to 'w', returning the number of bytes written.
+// Expired recording URLs are refreshed and interrupted downloads resumed.
+func (service *CallsService) DownloadRecording(ctx context.Context, callID int, w io.Writer) (int64, error) {
+	ctx = withOperation(ctx, "Call.DownloadRecording")
+
+	download := &mediaDownload{service: service, callID: callID, media: func(call *Call) string {
+		return call.Recording
+	}}
+
+	return download.run(ctx, w)
+}
+
+//  ***********************************************************************************
+//  DOWNLOAD CALL VOICEMAIL
+//  ***********************************************************************************
+
+// Download the call voicemail to 'w', returning the number of bytes written.
+// Expired voicemail URLs are refreshed and interrupted downloads resumed.
+func (service *CallsService) DownloadVoicemail(ctx context.Context, callID int, w io.Writer) (int64, error) {
+	ctx = withOperation(ctx, "Call.DownloadVoicemail")
+
+	download := &mediaDownload{service: service, callID: callID, media: func(call *Call) string {
+		return call.Voicemail
+	}}
+
+	return download.run(ctx, w)
+}
+
+// run downloads the media. Transfer failures are retried with the client
+// retry policy, expired URLs are refreshed up to downloadMaxRefreshes times
+// on top of it.
+func (download *mediaDownload) run(ctx context.Context, w io.Writer) (int64, error) {
+	if err := download.refresh(ctx); err != nil {
+		return 0, err
+	}
+
+	client := download.service.client
+	attempts := 0
+
+	for {
+		done, retry, err := download.attempt(ctx, w)
+
+		if done {
+			return download.written, nil
+		}
+
+		if !retry {
+			return download.written, err
+		}
+
+		if errors.Is(err, errMediaURLRefreshed) {
+			continue
+		}
+
+		attempts++
+
+		if attempts >= client.retryPolicy.MaxAttempts {
+			return download.written, &RetryError{Attempts: attempts, Err: err}
+		}
+
+		if err := sleepContext(ctx, client.retryPolicy.backoff(attempts, nil)); err != nil {
+			return download.written, err
+		}
+	}
+}
+
+// refresh fetches the call for a fresh, pre-signed media URL
+func (download *mediaDownload) refresh(ctx context.Context) error {
+	response, _, err := download.service.GetContext(ctx, download.callID)
+	if err != nil {
+		return err
+	}
+
+	if response.Call == nil || download.media(response.Call) == "" {
+		return ErrMediaUnavailable
+	}
+
+	download.url = download.media(response.Call)
+
+	return nil
+}
+
+// attempt requests the remaining bytes and streams them. Reports whether the
+// download is complete, or may be retried.
+func (download *mediaDownload) attempt(ctx context.Context, w io.Writer) (bool, bool, error) {
+	client := download.service.client
+
+	// Pre-signed URLs carry their own credentials (no API authorization)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, download.url, nil)
+	if err != nil {
+		return false, false, err
+	}
+
+	req.Header.Set("User-Agent", client.userAgent)
+
+	if download.written > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", download.written))
+	}
+
+	response, err := client.client.Do(req)
+	if err != nil {
+		return false, ctx.Err() == nil, err
+	}
+
+	defer response.Body.Close()
+
+	switch code := response.StatusCode; {
+	case code == http.StatusOK:
+		// Range ignored? (skip the bytes already written)
+		if download.written > 0 {
+			if _, err := io.CopyN(io.Discard, response.Body, download.written); err != nil {
+				return false, true, err
+			}
+		}
+
+		download.total = response.ContentLength
+	case code == http.StatusPartialContent:
+		start, total, ok := parseContentRange(response.Header.Get("Content-Range"))
+
+		if !ok || start != download.written {
+			return false, false, fmt.Errorf("%w: unexpected content range %q", ErrMediaIncomplete, response.Header.Get("Content-Range"))
+		}
+
+		download.total = total
+	case code == http.StatusRequestedRangeNotSatisfiable && download.written > 0:
+		// Everything was already written
+		return true, false, nil
+	case code == http.StatusUnauthorized || code == http.StatusForbidden || code == http.StatusNotFound || code == http.StatusGone:
+		// Pre-signed URL expired
+		if download.refreshes >= downloadMaxRefreshes {
+			return false, false, checkResponse(response)
+		}
+
+		download.refreshes++
+
+		if err := download.refresh(ctx); err != nil {
+			return false, false, err
+		}
+
+		return false, true, errMediaURLRefreshed
+	default:
+		err := checkResponse(response)
+
+		return false, client.retryPolicy.isRetryableStatus(code), err
+	}
+
+	if err := validateMediaContentType(response.Header.Get("Content-Type")); err != nil {
+		return false, false, err
+	}
+
+	written, err := io.Copy(w, &mediaReader{reader: response.Body})
+	download.written += written
+
+	var readErr *mediaReadError
+
+	switch {
+	case errors.As(err, &readErr):
+		// Connection dropped, resume from the last byte written
+		return false, ctx.Err() == nil, readErr.err
+	case err != nil:
+		// Writer failure, not retried
+		return false, false, err
+	case download.total > 0 && download.written < download.total:
+		return false, true, fmt.Errorf("%w: %d of %d bytes", ErrMediaIncomplete, download.written, download.total)
+	}
+
+	return true, false, nil
+}
+
+// mediaReader tells body read errors apart from writer errors during io.Copy
+type mediaReader struct {
+	reader io.Reader
+}
+
+type mediaReadError struct {
+	err error
+}
+
+func (e *mediaReadError) Error() string {
+	return e.err.Error()
+}
+
+func (reader *mediaReader) Read(p []byte) (int, error) {
+	n, err := reader.reader.Read(p)
+
+	if err != nil && err != io.EOF {
+		return n, &mediaReadError{err: err}
+	}
+
+	return n, err
+}
+
+// validateMediaContentType accepts audio and generic binary content, or a
+// missing content type
+func validateMediaContentType(contentType string) error {
+	if contentType == "" {
+		return nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return &MediaContentTypeError{ContentType: contentType}
+	}
+
+	for _, accepted := range mediaContentTypes {
+		if strings.HasPrefix(mediaType, accepted) {
+			return nil
+		}
+	}
+
+	return &MediaContentTypeError{ContentType: contentType}
+}
+
+// parseContentRange reads a 'bytes start-end/total' header. Total is -1 when
+// unknown.
+func parseContentRange(value string) (int64, int64, bool) {
+	value, ok := strings.CutPrefix(value, "bytes ")
+	if !ok {
+		return 0, 0, false
+	}
+
+	span, size, ok := strings.Cut(value, "/")
+	if !ok {
+		return 0, 0, false
+	}
+
+	first, _, ok := strings.Cut(span, "-")
+	if !ok {
+		return 0, 0, false
+	}
+
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	total := int64(-1)
+
+	if size != "*" {
+		if total, err = strconv.ParseInt(size, 10, 64); err != nil {
+			return 0, 0, false
+		}
+	}
+
+	return start, total, true
+}
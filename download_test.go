@@ -0,0 +1,150 @@
+package aircall
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// mediaServer serves a call whose recording URL changes on every fetch, and
+// the recording with the given media handler
+type mediaServer struct {
+	*httptest.Server
+
+	mutex       sync.Mutex
+	callFetches int
+	ranges      []string
+}
+
+func newMediaServer(t *testing.T, media func(w http.ResponseWriter, r *http.Request, version int)) *mediaServer {
+	server := &mediaServer{}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /v1/calls/1", func(w http.ResponseWriter, r *http.Request) {
+		server.mutex.Lock()
+		server.callFetches++
+		version := server.callFetches
+		server.mutex.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"call":{"id":1,"recording":"%s/media/%d"}}`, server.URL, version)
+	})
+
+	mux.HandleFunc("GET /media/{version}", func(w http.ResponseWriter, r *http.Request) {
+		var version int
+		fmt.Sscan(r.PathValue("version"), &version)
+
+		server.mutex.Lock()
+		server.ranges = append(server.ranges, r.Header.Get("Range"))
+		server.mutex.Unlock()
+
+		media(w, r, version)
+	})
+
+	server.Server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func (server *mediaServer) client() *Client {
+	client := NewWithConfig(ClientConfig{
+		RestEndpointURL: server.URL + "/v1/",
+		RetryPolicy:     &RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond},
+	})
+
+	client.Authenticate("token")
+
+	return client
+}
+
+func TestDownloadRecordingRefreshesExpiredURL(t *testing.T) {
+	server := newMediaServer(t, func(w http.ResponseWriter, r *http.Request, version int) {
+		// The first pre-signed URL has expired
+		if version == 1 {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		w.Header().Set("Content-Type", "audio/mpeg")
+		io.WriteString(w, "recording")
+	})
+
+	buffer := bytes.Buffer{}
+
+	written, err := server.client().Call.DownloadRecording(context.Background(), 1, &buffer)
+	if err != nil {
+		t.Fatalf("DownloadRecording() error = %v", err)
+	}
+
+	if written != 9 || buffer.String() != "recording" {
+		t.Errorf("DownloadRecording() = %d %q, want 9 %q", written, buffer.String(), "recording")
+	}
+
+	if server.callFetches != 2 {
+		t.Errorf("call fetched %d times, want 2", server.callFetches)
+	}
+}
+
+func TestDownloadRecordingResumesDroppedBody(t *testing.T) {
+	content := "0123456789"
+
+	server := newMediaServer(t, func(w http.ResponseWriter, r *http.Request, version int) {
+		w.Header().Set("Content-Type", "audio/mpeg")
+
+		if r.Header.Get("Range") == "" {
+			// Announce the whole content, then drop the connection half way
+			w.Header().Set("Content-Length", fmt.Sprint(len(content)))
+			io.WriteString(w, content[:4])
+			return
+		}
+
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes 4-9/%d", len(content)))
+		w.WriteHeader(http.StatusPartialContent)
+		io.WriteString(w, content[4:])
+	})
+
+	buffer := bytes.Buffer{}
+
+	written, err := server.client().Call.DownloadRecording(context.Background(), 1, &buffer)
+	if err != nil {
+		t.Fatalf("DownloadRecording() error = %v", err)
+	}
+
+	if written != int64(len(content)) || buffer.String() != content {
+		t.Errorf("DownloadRecording() = %d %q, want %d %q", written, buffer.String(), len(content), content)
+	}
+
+	if len(server.ranges) != 2 || server.ranges[1] != "bytes=4-" {
+		t.Errorf("Range headers = %q, want [\"\" \"bytes=4-\"]", server.ranges)
+	}
+}
+
+func TestDownloadRecordingRejectsContentType(t *testing.T) {
+	server := newMediaServer(t, func(w http.ResponseWriter, r *http.Request, version int) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		io.WriteString(w, "<html>Access denied</html>")
+	})
+
+	buffer := bytes.Buffer{}
+
+	_, err := server.client().Call.DownloadRecording(context.Background(), 1, &buffer)
+
+	var contentTypeErr *MediaContentTypeError
+
+	if !errors.Is(err, ErrMediaContentType) || !errors.As(err, &contentTypeErr) {
+		t.Fatalf("DownloadRecording() error = %v, want ErrMediaContentType", err)
+	}
+
+	if buffer.Len() != 0 {
+		t.Errorf("wrote %q, want nothing", buffer.String())
+	}
+}
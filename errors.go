@@ -0,0 +1,80 @@
+package aircall
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	headerRequestID = "X-Request-Id"
+)
+
+// Errors matched by ErrorResponse with errors.Is, depending on the HTTP status.
+var (
+	ErrNotFound     = errors.New("aircall: resource not found")
+	ErrUnauthorized = errors.New("aircall: unauthorized")
+	ErrForbidden    = errors.New("aircall: forbidden")
+	ErrRateLimited  = errors.New("aircall: rate limited")
+	ErrValidation   = errors.New("aircall: validation failed")
+	ErrServer       = errors.New("aircall: server error")
+
+	// Matched by the error returned when all request attempts failed
+	ErrAttemptsExhausted = errors.New("aircall: all request attempts were exhausted")
+)
+
+// Is reports whether the error response matches one of the sentinel errors.
+func (response *ErrorResponse) Is(target error) bool {
+	if response.Response == nil {
+		return false
+	}
+
+	switch code := response.Response.StatusCode; {
+	case code == http.StatusNotFound:
+		return target == ErrNotFound
+	case code == http.StatusUnauthorized:
+		return target == ErrUnauthorized
+	case code == http.StatusForbidden:
+		return target == ErrForbidden
+	case code == http.StatusTooManyRequests:
+		return target == ErrRateLimited
+	case code == http.StatusBadRequest || code == http.StatusUnprocessableEntity:
+		return target == ErrValidation
+	case code >= 500:
+		return target == ErrServer
+	}
+
+	return false
+}
+
+// StatusCode returns the HTTP status of the error response
+func (response *ErrorResponse) StatusCode() int {
+	if response.Response == nil {
+		return 0
+	}
+
+	return response.Response.StatusCode
+}
+
+// RetryError is returned when all request attempts failed. It wraps the
+// error of the last attempt and matches ErrAttemptsExhausted.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("%s (%d attempts)", ErrAttemptsExhausted, e.Attempts)
+	}
+
+	return fmt.Sprintf("%s (%d attempts): %v", ErrAttemptsExhausted, e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() []error {
+	if e.Err == nil {
+		return []error{ErrAttemptsExhausted}
+	}
+
+	return []error{ErrAttemptsExhausted, e.Err}
+}
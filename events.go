@@ -8,7 +8,7 @@ import (
 
 type InboundWebhook struct {
 	Resource  string          `json:"resource,omitempty"`
-	Event     string          `json:"event,omitempty"`
+	Event     WebhookEvent    `json:"event,omitempty"`
 	EventName string          `json:"event_name,omitempty"`
 	Timestamp int             `json:"timestamp,omitempty"`
 	Token     string          `json:"token,omitempty"`
@@ -100,12 +100,8 @@ func (w *InboundWebhook) GetIntegrationData() (*Integration, error) {
 }
 
 func (w *InboundWebhook) GetConversationIntelligenceSentimentData() (*ConversationIntelligenceSentiment, error) {
-	if w.Resource != "conversation_intelligence" {
-		return nil, fmt.Errorf("Resource is %s not a conversation intelligence.", w.Resource)
-	}
-
-	if eventParts := strings.Split(w.Event, "."); len(eventParts) > 0 && eventParts[0] != "sentiment" {
-		return nil, fmt.Errorf("Resource is %s, but event is %s not a supported.", w.Resource, w.Event)
+	if err := w.checkConversationIntelligence("sentiment"); err != nil {
+		return nil, err
 	}
 
 	sentiment := ConversationIntelligenceSentiment{}
@@ -118,12 +114,8 @@ func (w *InboundWebhook) GetConversationIntelligenceSentimentData() (*Conversati
 }
 
 func (w *InboundWebhook) GetConversationIntelligenceTranscriptionData() (*ConversationIntelligenceTranscription, error) {
-	if w.Resource != "conversation_intelligence" {
-		return nil, fmt.Errorf("Resource is %s not a conversation intelligence.", w.Resource)
-	}
-
-	if eventParts := strings.Split(w.Event, "."); len(eventParts) > 0 && eventParts[0] != "transcription" {
-		return nil, fmt.Errorf("Resource is %s, but event is %s not a supported.", w.Resource, w.Event)
+	if err := w.checkConversationIntelligence("transcription"); err != nil {
+		return nil, err
 	}
 
 	transcription := ConversationIntelligenceTranscription{}
@@ -134,3 +126,85 @@ func (w *InboundWebhook) GetConversationIntelligenceTranscriptionData() (*Conver
 
 	return &transcription, nil
 }
+
+func (w *InboundWebhook) GetConversationIntelligenceTopicData() (*ConversationIntelligenceTopic, error) {
+	if err := w.checkConversationIntelligence("topic"); err != nil {
+		return nil, err
+	}
+
+	topic := ConversationIntelligenceTopic{}
+
+	if err := json.Unmarshal(w.Data, &topic); err != nil {
+		return nil, err
+	}
+
+	return &topic, nil
+}
+
+func (w *InboundWebhook) GetConversationIntelligenceSummaryData() (*ConversationIntelligenceSummary, error) {
+	if err := w.checkConversationIntelligence("summary"); err != nil {
+		return nil, err
+	}
+
+	summary := ConversationIntelligenceSummary{}
+
+	if err := json.Unmarshal(w.Data, &summary); err != nil {
+		return nil, err
+	}
+
+	return &summary, nil
+}
+
+func (w *InboundWebhook) GetConversationIntelligenceActionItemData() (*ConversationIntelligenceActionItem, error) {
+	if err := w.checkConversationIntelligence("action_item"); err != nil {
+		return nil, err
+	}
+
+	actionItem := ConversationIntelligenceActionItem{}
+
+	if err := json.Unmarshal(w.Data, &actionItem); err != nil {
+		return nil, err
+	}
+
+	return &actionItem, nil
+}
+
+// conversationIntelligenceKind returns the kind of a conversation
+// intelligence event (eg. "sentiment" for "sentiment.created"). Aircall sends
+// these with the "conversation_intelligence" resource, or with the kind itself
+// as resource.
+func (w *InboundWebhook) conversationIntelligenceKind() string {
+	kind, _, _ := strings.Cut(string(w.Event), ".")
+
+	if w.Resource != WebhookResourceConversationIntelligence && w.Resource != kind {
+		return ""
+	}
+
+	return kind
+}
+
+func (w *InboundWebhook) checkConversationIntelligence(kind string) error {
+	if w.Resource != WebhookResourceConversationIntelligence && w.Resource != kind {
+		return fmt.Errorf("Resource is %s not a conversation intelligence.", w.Resource)
+	}
+
+	if w.conversationIntelligenceKind() != kind {
+		return fmt.Errorf("Resource is %s, but event is %s not a %s.", w.Resource, w.Event, kind)
+	}
+
+	return nil
+}
+
+// DeliveryKey identifies a webhook delivery: Aircall retries deliver the same
+// resource, event, data ID and timestamp.
+func (w *InboundWebhook) DeliveryKey() string {
+	data := struct {
+		ID json.RawMessage `json:"id,omitempty"`
+	}{}
+
+	_ = json.Unmarshal(w.Data, &data)
+
+	id := strings.Trim(string(data.ID), `"`)
+
+	return fmt.Sprintf("%s:%s:%s:%d", w.Resource, w.Event, id, w.Timestamp)
+}
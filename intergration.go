@@ -1,5 +1,7 @@
 package aircall
 
+import "context"
+
 // Integration service
 type IntegrationService service
 
@@ -30,10 +32,17 @@ type Integration struct {
 
 // Get an integration. Reference: https://developer.aircall.io/api-references/#retrieve-a-integration
 func (service *IntegrationService) Get() (*IntegrationResponse, *Response, error) {
+	return service.GetContext(context.Background())
+}
+
+// Get an integration using the given context. Reference: https://developer.aircall.io/api-references/#retrieve-a-integration
+func (service *IntegrationService) GetContext(ctx context.Context) (*IntegrationResponse, *Response, error) {
+	ctx = withOperation(ctx, "Integration.Get")
+
 	_url := "integrations/me"
 
 	responseBody := new(IntegrationResponse)
-	response, err := service.client.Get(_url, nil, responseBody)
+	response, err := service.client.GetContext(ctx, _url, nil, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -49,13 +58,20 @@ func (service *IntegrationService) Get() (*IntegrationResponse, *Response, error
 
 // Enable an integration. Reference: https://developer.aircall.io/api-references/#enable-integration
 func (service *IntegrationService) Enable(install ...bool) (*Response, error) {
+	return service.EnableContext(context.Background(), install...)
+}
+
+// Enable an integration using the given context. Reference: https://developer.aircall.io/api-references/#enable-integration
+func (service *IntegrationService) EnableContext(ctx context.Context, install ...bool) (*Response, error) {
+	ctx = withOperation(ctx, "Integration.Enable")
+
 	_url := "integrations/enable"
 
 	if len(install) > 0 && install[0] {
 		_url += "?install=true"
 	}
 
-	return service.client.Post(_url, nil, nil)
+	return service.client.PostContext(ctx, _url, nil, nil)
 }
 
 //  ***********************************************************************************
@@ -65,7 +81,14 @@ func (service *IntegrationService) Enable(install ...bool) (*Response, error) {
 
 // Disable an integration. Reference: https://developer.aircall.io/api-references/#disable-integration
 func (service *IntegrationService) Disable() (*Response, error) {
+	return service.DisableContext(context.Background())
+}
+
+// Disable an integration using the given context. Reference: https://developer.aircall.io/api-references/#disable-integration
+func (service *IntegrationService) DisableContext(ctx context.Context) (*Response, error) {
+	ctx = withOperation(ctx, "Integration.Disable")
+
 	_url := "integrations/disable"
 
-	return service.client.Post(_url, nil, nil)
+	return service.client.PostContext(ctx, _url, nil, nil)
 }
@@ -1,6 +1,9 @@
 package aircall
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // Message service
 type MessagesService service
@@ -17,11 +20,11 @@ type Message struct {
 	Direction      string         `json:"direction,omitempty"`
 	ExternalNumber string         `json:"external_number,omitempty"`
 	Body           string         `json:"body,omitempty"`
-	Status         string         `json:"status,omitempty"`
+	Status         MessageStatus  `json:"status,omitempty"`
 	RawDigits      string         `json:"raw_digits,omitempty"`
-	CreatedAt      interface{}    `json:"created_at,omitempty"`
-	UpdatedAt      interface{}    `json:"updated_at,omitempty"`
-	SentAt         interface{}    `json:"sent_at,omitempty"`
+	CreatedAt      *Timestamp     `json:"created_at,omitempty"`
+	UpdatedAt      *Timestamp     `json:"updated_at,omitempty"`
+	SentAt         *Timestamp     `json:"sent_at,omitempty"`
 	MediaURL       *[]string      `json:"media_url,omitempty"`
 	User           *User          `json:"user,omitempty"`
 	Number         *Number        `json:"number,omitempty"`
@@ -29,6 +32,26 @@ type Message struct {
 	MediaDetails   *[]MediaDetail `json:"media_details,omitempty"`
 }
 
+// Delivery status of a message.
+type MessageStatus string
+
+const (
+	MessageStatusPending   MessageStatus = "pending"
+	MessageStatusSent      MessageStatus = "sent"
+	MessageStatusDelivered MessageStatus = "delivered"
+	MessageStatusFailed    MessageStatus = "failed"
+	MessageStatusReceived  MessageStatus = "received"
+)
+
+func (status MessageStatus) IsValid() bool {
+	switch status {
+	case MessageStatusPending, MessageStatusSent, MessageStatusDelivered, MessageStatusFailed, MessageStatusReceived:
+		return true
+	}
+
+	return false
+}
+
 type NewMessage struct {
 	To       string   `json:"to,omitempty"`
 	Body     string   `json:"body,omitempty"`
@@ -48,10 +71,17 @@ type MediaDetail struct {
 
 // Create number configuration. Reference: https://developer.aircall.io/api-references/#create-number-configuration
 func (service *MessagesService) CreateNumberConfiguration(numberID int, numberConfiguration *NumberConfiguration) (*NumberConfiguration, *Response, error) {
+	return service.CreateNumberConfigurationContext(context.Background(), numberID, numberConfiguration)
+}
+
+// Create number configuration using the given context. Reference: https://developer.aircall.io/api-references/#create-number-configuration
+func (service *MessagesService) CreateNumberConfigurationContext(ctx context.Context, numberID int, numberConfiguration *NumberConfiguration) (*NumberConfiguration, *Response, error) {
+	ctx = withOperation(ctx, "Message.CreateNumberConfiguration")
+
 	_url := fmt.Sprintf("numbers/%d/messages/configuration", numberID)
 
 	responseBody := new(NumberConfiguration)
-	response, err := service.client.Post(_url, numberConfiguration, responseBody)
+	response, err := service.client.PostContext(ctx, _url, numberConfiguration, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -67,10 +97,17 @@ func (service *MessagesService) CreateNumberConfiguration(numberID int, numberCo
 
 // Get number configuration. Reference: https://developer.aircall.io/api-references/#fetch-number-configuration
 func (service *MessagesService) GetNumberConfiguration(numberID int) (*NumberConfiguration, *Response, error) {
+	return service.GetNumberConfigurationContext(context.Background(), numberID)
+}
+
+// Get number configuration using the given context. Reference: https://developer.aircall.io/api-references/#fetch-number-configuration
+func (service *MessagesService) GetNumberConfigurationContext(ctx context.Context, numberID int) (*NumberConfiguration, *Response, error) {
+	ctx = withOperation(ctx, "Message.GetNumberConfiguration")
+
 	_url := fmt.Sprintf("numbers/%d/messages/configuration", numberID)
 
 	responseBody := new(NumberConfiguration)
-	response, err := service.client.Get(_url, nil, responseBody)
+	response, err := service.client.GetContext(ctx, _url, nil, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -86,9 +123,16 @@ func (service *MessagesService) GetNumberConfiguration(numberID int) (*NumberCon
 
 // Delete number configuration. Reference: https://developer.aircall.io/api-references/#fetch-number-configuration
 func (service *MessagesService) DeleteNumberConfiguration(numberID int) (*Response, error) {
+	return service.DeleteNumberConfigurationContext(context.Background(), numberID)
+}
+
+// Delete number configuration using the given context. Reference: https://developer.aircall.io/api-references/#fetch-number-configuration
+func (service *MessagesService) DeleteNumberConfigurationContext(ctx context.Context, numberID int) (*Response, error) {
+	ctx = withOperation(ctx, "Message.DeleteNumberConfiguration")
+
 	_url := fmt.Sprintf("numbers/%d/messages/configuration", numberID)
 
-	return service.client.Delete(_url)
+	return service.client.DeleteContext(ctx, _url)
 }
 
 //  ***********************************************************************************
@@ -98,10 +142,17 @@ func (service *MessagesService) DeleteNumberConfiguration(numberID int) (*Respon
 
 // Send message. Reference: https://developer.aircall.io/api-references/#send-message
 func (service *MessagesService) Send(numberID int, message *NewMessage) (*Message, *Response, error) {
+	return service.SendContext(context.Background(), numberID, message)
+}
+
+// Send message using the given context. Reference: https://developer.aircall.io/api-references/#send-message
+func (service *MessagesService) SendContext(ctx context.Context, numberID int, message *NewMessage) (*Message, *Response, error) {
+	ctx = withOperation(ctx, "Message.Send")
+
 	_url := fmt.Sprintf("numbers/%d/messages/send", numberID)
 
 	responseBody := new(Message)
-	response, err := service.client.Post(_url, message, responseBody)
+	response, err := service.client.PostContext(ctx, _url, message, responseBody)
 
 	if err != nil {
 		return nil, response, err
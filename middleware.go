@@ -0,0 +1,121 @@
+package aircall
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+type operationContextKey struct{}
+
+// Operation is an API call going through the client middleware chain.
+type Operation struct {
+	// Service operation name (eg. "Call.List"). Empty for raw client calls.
+	Name string
+
+	// Request about to be sent. Middlewares may change its headers.
+	Request *http.Request
+
+	// Destination of the decoded response body. Populated once the next
+	// handler returns.
+	Result interface{}
+}
+
+// Handler sends an operation and returns its response.
+type Handler func(op *Operation) (*Response, error)
+
+// Middleware wraps a Handler, seeing every operation sent by the client.
+type Middleware func(next Handler) Handler
+
+// Use registers middlewares on the client. The first registered middleware
+// is the outermost one.
+func (client *Client) Use(middlewares ...Middleware) {
+	client.middlewares = append(client.middlewares, middlewares...)
+}
+
+// handler builds the middleware chain around the given handler
+func (client *Client) handler(final Handler) Handler {
+	handler := final
+
+	for i := len(client.middlewares) - 1; i >= 0; i-- {
+		handler = client.middlewares[i](handler)
+	}
+
+	return handler
+}
+
+// withOperation names the service operation performed with the context
+func withOperation(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operationContextKey{}, name)
+}
+
+// operationName returns the service operation name held by the context
+func operationName(ctx context.Context) string {
+	name, _ := ctx.Value(operationContextKey{}).(string)
+
+	return name
+}
+
+// LoggingMiddleware logs every operation with its status and duration.
+// Failed operations are logged at error level.
+func LoggingMiddleware(logger *slog.Logger) Middleware {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return func(next Handler) Handler {
+		return func(op *Operation) (*Response, error) {
+			start := time.Now()
+
+			response, err := next(op)
+
+			attrs := []slog.Attr{
+				slog.String("operation", op.Name),
+				slog.String("method", op.Request.Method),
+				slog.String("path", op.Request.URL.Path),
+				slog.Duration("duration", time.Since(start)),
+			}
+
+			if requestID := op.Request.Header.Get(headerRequestID); requestID != "" {
+				attrs = append(attrs, slog.String("request_id", requestID))
+			}
+
+			if response != nil && response.Response != nil {
+				attrs = append(attrs, slog.Int("status", response.StatusCode))
+			}
+
+			if err != nil {
+				attrs = append(attrs, slog.String("error", err.Error()))
+				logger.LogAttrs(op.Request.Context(), slog.LevelError, "aircall request failed", attrs...)
+			} else {
+				logger.LogAttrs(op.Request.Context(), slog.LevelDebug, "aircall request", attrs...)
+			}
+
+			return response, err
+		}
+	}
+}
+
+// RequestIDMiddleware sets a random 'X-Request-Id' header on requests that
+// do not carry one already.
+func RequestIDMiddleware() Middleware {
+	return func(next Handler) Handler {
+		return func(op *Operation) (*Response, error) {
+			if op.Request.Header.Get(headerRequestID) == "" {
+				op.Request.Header.Set(headerRequestID, newRequestID())
+			}
+
+			return next(op)
+		}
+	}
+}
+
+func newRequestID() string {
+	data := make([]byte, 16)
+	_, _ = rand.Read(data)
+
+	return hex.EncodeToString(data)
+}
@@ -1,6 +1,11 @@
 package aircall
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+)
 
 // Number service
 type NumbersService service
@@ -15,20 +20,20 @@ type NumberResponse struct {
 }
 
 type Number struct {
-	ID                     int         `json:"id,omitempty"`
-	DirectLink             string      `json:"direct_link,omitempty"`
-	Name                   string      `json:"name,omitempty"`
-	Digits                 string      `json:"digits,omitempty"`
-	CreatedAt              interface{} `json:"created_at,omitempty"`
-	Country                string      `json:"country,omitempty"`
-	TimeZone               string      `json:"time_zone,omitempty"`
-	Open                   bool        `json:"open,omitempty"`
-	AvailabilityStatus     string      `json:"availability_status,omitempty"`
-	IsIVR                  bool        `json:"is_ivr,omitempty"`
-	LiveRecordingActivated bool        `json:"live_recording_activated,omitempty"`
-	Priority               *int        `json:"priority,omitempty"`
-	Messages               *Messages   `json:"messages,omitempty"`
-	Users                  *[]User     `json:"users,omitempty"`
+	ID                     int        `json:"id,omitempty"`
+	DirectLink             string     `json:"direct_link,omitempty"`
+	Name                   string     `json:"name,omitempty"`
+	Digits                 string     `json:"digits,omitempty"`
+	CreatedAt              *Timestamp `json:"created_at,omitempty"`
+	Country                string     `json:"country,omitempty"`
+	TimeZone               string     `json:"time_zone,omitempty"`
+	Open                   bool       `json:"open,omitempty"`
+	AvailabilityStatus     string     `json:"availability_status,omitempty"`
+	IsIVR                  bool       `json:"is_ivr,omitempty"`
+	LiveRecordingActivated bool       `json:"live_recording_activated,omitempty"`
+	Priority               *int       `json:"priority,omitempty"`
+	Messages               *Messages  `json:"messages,omitempty"`
+	Users                  *[]User    `json:"users,omitempty"`
 }
 
 type Messages struct {
@@ -50,6 +55,11 @@ type ListNumbersQueryParams struct {
 	QueryValues
 }
 
+// Create Query parameters for accounts routes.
+func (service *NumbersService) Query() *NumberQueries {
+	return &NumberQueries{}
+}
+
 //  ***********************************************************************************
 //  LIST ALL NUMBERS
 //  https://developer.aircall.io/api-references/#list-all-numbers
@@ -72,9 +82,19 @@ func (p ListNumbersQueryParams) To(value string) {
 	p.to(value)
 }
 
+// Set 'from' as a time for 'List' method.
+func (p ListNumbersQueryParams) FromTime(value time.Time) {
+	p.fromTime(value)
+}
+
+// Set 'to' as a time for 'List' method.
+func (p ListNumbersQueryParams) ToTime(value time.Time) {
+	p.toTime(value)
+}
+
 // Set 'order' for for 'List' method.
-func (p ListNumbersQueryParams) Order(value string) {
-	p.order(value)
+func (p ListNumbersQueryParams) Order(value SortOrder) {
+	p.order(string(value))
 }
 
 // Set 'page' and 'per_page' for for 'List' method.
@@ -84,10 +104,17 @@ func (p ListNumbersQueryParams) Paginate(page int, perPage int) {
 
 // List calls. Reference: https://developer.aircall.io/api-references/#list-all-calls
 func (service *NumbersService) List(opts *ListNumbersQueryParams) (*NumbersResponse, *Response, error) {
+	return service.ListContext(context.Background(), opts)
+}
+
+// List calls using the given context. Reference: https://developer.aircall.io/api-references/#list-all-calls
+func (service *NumbersService) ListContext(ctx context.Context, opts *ListNumbersQueryParams) (*NumbersResponse, *Response, error) {
+	ctx = withOperation(ctx, "Number.List")
+
 	_url := "numbers"
 
 	responseBody := new(NumbersResponse)
-	response, err := service.client.Get(_url, opts, responseBody)
+	response, err := service.client.GetContext(ctx, _url, opts, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -96,6 +123,18 @@ func (service *NumbersService) List(opts *ListNumbersQueryParams) (*NumbersRespo
 	return responseBody, response, nil
 }
 
+// Iterate over all numbers, following pagination. An optional cap on the number
+// of items can be given. Reference: https://developer.aircall.io/api-references/#list-all-numbers
+func (service *NumbersService) All(ctx context.Context, opts *ListNumbersQueryParams, maxItems ...int) iter.Seq2[Number, error] {
+	ctx = withOperation(ctx, "Number.All")
+
+	_url := "numbers"
+
+	return paginate(ctx, service.client, _url, opts, maxItems, func(page *NumbersResponse) (*GenericResponseMeta, *[]Number) {
+		return page.Meta, page.Numbers
+	})
+}
+
 //  ***********************************************************************************
 //  GET NUMBER
 //  https://developer.aircall.io/api-references/#retrieve-a-number
@@ -103,10 +142,17 @@ func (service *NumbersService) List(opts *ListNumbersQueryParams) (*NumbersRespo
 
 // Get a number. Reference: https://developer.aircall.io/api-references/#retrieve-a-number
 func (service *NumbersService) Get(numberID int) (*NumberResponse, *Response, error) {
+	return service.GetContext(context.Background(), numberID)
+}
+
+// Get a number using the given context. Reference: https://developer.aircall.io/api-references/#retrieve-a-number
+func (service *NumbersService) GetContext(ctx context.Context, numberID int) (*NumberResponse, *Response, error) {
+	ctx = withOperation(ctx, "Number.Get")
+
 	_url := fmt.Sprintf("numbers/%d", numberID)
 
 	responseBody := new(NumberResponse)
-	response, err := service.client.Get(_url, nil, responseBody)
+	response, err := service.client.GetContext(ctx, _url, nil, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -122,10 +168,17 @@ func (service *NumbersService) Get(numberID int) (*NumberResponse, *Response, er
 
 // Update a number. Reference: https://developer.aircall.io/api-references/#update-a-number
 func (service *NumbersService) Update(numberID int, number *Number) (*NumberResponse, *Response, error) {
+	return service.UpdateContext(context.Background(), numberID, number)
+}
+
+// Update a number using the given context. Reference: https://developer.aircall.io/api-references/#update-a-number
+func (service *NumbersService) UpdateContext(ctx context.Context, numberID int, number *Number) (*NumberResponse, *Response, error) {
+	ctx = withOperation(ctx, "Number.Update")
+
 	_url := fmt.Sprintf("numbers/%d", numberID)
 
 	responseBody := new(NumberResponse)
-	response, err := service.client.Put(_url, number, responseBody)
+	response, err := service.client.PutContext(ctx, _url, number, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -141,10 +194,17 @@ func (service *NumbersService) Update(numberID int, number *Number) (*NumberResp
 
 // Update music and messages. Reference: https://developer.aircall.io/api-references/#update-music-and-messages
 func (service *MessagesService) UpdateMessages(numberID int, messages *Messages) (*NumberResponse, *Response, error) {
+	return service.UpdateMessagesContext(context.Background(), numberID, messages)
+}
+
+// Update music and messages using the given context. Reference: https://developer.aircall.io/api-references/#update-music-and-messages
+func (service *MessagesService) UpdateMessagesContext(ctx context.Context, numberID int, messages *Messages) (*NumberResponse, *Response, error) {
+	ctx = withOperation(ctx, "Message.UpdateMessages")
+
 	_url := fmt.Sprintf("numbers/%d", numberID)
 
 	responseBody := new(NumberResponse)
-	response, err := service.client.Put(_url, messages, responseBody)
+	response, err := service.client.PutContext(ctx, _url, messages, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -0,0 +1,221 @@
+// Package oauth implements the Aircall OAuth 2.0 install flow for Aircall
+// apps: consent URL, authorization code exchange, token refresh and storage.
+package oauth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultAuthURL  = "https://dashboard.aircall.io/oauth/authorize"
+	defaultTokenURL = "https://api.aircall.io/v1/oauth/token"
+	defaultScope    = "public_api"
+	clientTimeout   = 10
+
+	grantTypeAuthorizationCode = "authorization_code"
+	grantTypeRefreshToken      = "refresh_token"
+
+	// Refresh tokens this long before they expire
+	expiryDelta = 30 * time.Second
+)
+
+var (
+	ErrTokenNotFound = errors.New("oauth: token not found")
+	ErrTokenExpired  = errors.New("oauth: token expired and cannot be refreshed")
+)
+
+// Config of an Aircall app.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+
+	// Defaults to "public_api"
+	Scopes []string
+
+	// Override Aircall endpoints (eg. for tests)
+	AuthURL  string
+	TokenURL string
+
+	HttpClient *http.Client
+}
+
+// Token issued by Aircall. Aircall tokens usually do not expire, in which
+// case 'Expiry' is zero.
+type Token struct {
+	AccessToken  string    `json:"access_token,omitempty"`
+	TokenType    string    `json:"token_type,omitempty"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	Scope        string    `json:"scope,omitempty"`
+	CreatedAt    int64     `json:"created_at,omitempty"`
+	ExpiresIn    int64     `json:"expires_in,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+// Valid reports whether the token can be used
+func (token *Token) Valid() bool {
+	if token == nil || token.AccessToken == "" {
+		return false
+	}
+
+	return token.Expiry.IsZero() || time.Now().Add(expiryDelta).Before(token.Expiry)
+}
+
+// TokenError is returned when Aircall rejects a token request.
+type TokenError struct {
+	StatusCode int
+
+	ErrorCode        string `json:"error,omitempty"`
+	ErrorDescription string `json:"error_description,omitempty"`
+	Message          string `json:"message,omitempty"`
+	Troubleshoot     string `json:"troubleshoot,omitempty"`
+}
+
+func (e *TokenError) Error() string {
+	errorString := fmt.Sprintf("oauth: token request failed: %d %s", e.StatusCode, e.ErrorCode)
+
+	if e.ErrorDescription != "" {
+		errorString = fmt.Sprintf("%s [%s]", errorString, e.ErrorDescription)
+	}
+
+	if e.Message != "" {
+		errorString = fmt.Sprintf("%s [%s]", errorString, e.Message)
+	}
+
+	if e.Troubleshoot != "" {
+		errorString = fmt.Sprintf("%s [%s]", errorString, e.Troubleshoot)
+	}
+
+	return errorString
+}
+
+// AuthCodeURL returns the Aircall consent page URL. 'state' is sent back to
+// the redirect URL and should be verified to prevent CSRF.
+func (config *Config) AuthCodeURL(state string) string {
+	authURL := config.AuthURL
+
+	if authURL == "" {
+		authURL = defaultAuthURL
+	}
+
+	scopes := config.Scopes
+
+	if len(scopes) == 0 {
+		scopes = []string{defaultScope}
+	}
+
+	values := url.Values{}
+	values.Set("client_id", config.ClientID)
+	values.Set("redirect_uri", config.RedirectURL)
+	values.Set("response_type", "code")
+	values.Set("scope", strings.Join(scopes, " "))
+
+	if state != "" {
+		values.Set("state", state)
+	}
+
+	separator := "?"
+
+	if strings.Contains(authURL, "?") {
+		separator = "&"
+	}
+
+	return authURL + separator + values.Encode()
+}
+
+// Exchange trades the authorization code received on the redirect URL for a
+// token.
+func (config *Config) Exchange(ctx context.Context, code string) (*Token, error) {
+	return config.requestToken(ctx, map[string]string{
+		"grant_type":   grantTypeAuthorizationCode,
+		"code":         code,
+		"redirect_uri": config.RedirectURL,
+	})
+}
+
+// Refresh trades a refresh token for a new token.
+func (config *Config) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	token, err := config.requestToken(ctx, map[string]string{
+		"grant_type":    grantTypeRefreshToken,
+		"refresh_token": refreshToken,
+	})
+
+	// Keep refresh token? (not rotated by the server)
+	if err == nil && token.RefreshToken == "" {
+		token.RefreshToken = refreshToken
+	}
+
+	return token, err
+}
+
+func (config *Config) requestToken(ctx context.Context, body map[string]string) (*Token, error) {
+	tokenURL := config.TokenURL
+
+	if tokenURL == "" {
+		tokenURL = defaultTokenURL
+	}
+
+	body["client_id"] = config.ClientID
+	body["client_secret"] = config.ClientSecret
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := config.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	data, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		tokenError := &TokenError{StatusCode: resp.StatusCode}
+		_ = json.Unmarshal(data, tokenError)
+
+		return nil, tokenError
+	}
+
+	token := new(Token)
+
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, err
+	}
+
+	if token.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+
+	return token, nil
+}
+
+func (config *Config) httpClient() *http.Client {
+	if config.HttpClient != nil {
+		return config.HttpClient
+	}
+
+	return &http.Client{Timeout: clientTimeout * time.Second}
+}
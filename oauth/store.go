@@ -0,0 +1,165 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// TokenStore persists tokens, keyed by account (eg. Aircall company ID).
+type TokenStore interface {
+	Load(ctx context.Context, key string) (*Token, error)
+	Save(ctx context.Context, key string, token *Token) error
+	Delete(ctx context.Context, key string) error
+}
+
+// MemoryStore keeps tokens in memory.
+type MemoryStore struct {
+	mutex  sync.RWMutex
+	tokens map[string]Token
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{tokens: map[string]Token{}}
+}
+
+func (store *MemoryStore) Load(ctx context.Context, key string) (*Token, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	token, ok := store.tokens[key]
+	if !ok {
+		return nil, ErrTokenNotFound
+	}
+
+	return &token, nil
+}
+
+func (store *MemoryStore) Save(ctx context.Context, key string, token *Token) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.tokens[key] = *token
+
+	return nil
+}
+
+func (store *MemoryStore) Delete(ctx context.Context, key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	delete(store.tokens, key)
+
+	return nil
+}
+
+// FileStore keeps tokens in a JSON file, readable by its owner only.
+type FileStore struct {
+	mutex sync.Mutex
+	path  string
+}
+
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+func (store *FileStore) Load(ctx context.Context, key string) (*Token, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	tokens, err := store.read()
+	if err != nil {
+		return nil, err
+	}
+
+	token, ok := tokens[key]
+	if !ok {
+		return nil, ErrTokenNotFound
+	}
+
+	return &token, nil
+}
+
+func (store *FileStore) Save(ctx context.Context, key string, token *Token) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	tokens, err := store.read()
+	if err != nil {
+		return err
+	}
+
+	tokens[key] = *token
+
+	return store.write(tokens)
+}
+
+func (store *FileStore) Delete(ctx context.Context, key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	tokens, err := store.read()
+	if err != nil {
+		return err
+	}
+
+	delete(tokens, key)
+
+	return store.write(tokens)
+}
+
+func (store *FileStore) read() (map[string]Token, error) {
+	tokens := map[string]Token{}
+
+	data, err := os.ReadFile(store.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return tokens, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &tokens); err != nil {
+			return nil, err
+		}
+	}
+
+	return tokens, nil
+}
+
+// write replaces the file atomically so readers never see partial content
+func (store *FileStore) write(tokens map[string]Token) error {
+	data, err := json.MarshalIndent(tokens, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(store.path), filepath.Base(store.path)+".*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Chmod(0600); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), store.path)
+}
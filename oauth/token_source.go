@@ -0,0 +1,58 @@
+package oauth
+
+import (
+	"context"
+	"sync"
+)
+
+// TokenSource provides the access token of one Aircall account, refreshing
+// and persisting it when needed. It satisfies aircall.TokenSource.
+type TokenSource struct {
+	mutex sync.Mutex
+
+	config *Config
+	store  TokenStore
+	key    string
+	token  *Token
+}
+
+// TokenSource returns a token source reading the token saved under 'key'
+// in the store.
+func (config *Config) TokenSource(store TokenStore, key string) *TokenSource {
+	return &TokenSource{config: config, store: store, key: key}
+}
+
+// Token returns a valid access token.
+func (source *TokenSource) Token(ctx context.Context) (string, error) {
+	source.mutex.Lock()
+	defer source.mutex.Unlock()
+
+	if source.token.Valid() {
+		return source.token.AccessToken, nil
+	}
+
+	// Load token from store? (first use, or refreshed elsewhere)
+	token, err := source.store.Load(ctx, source.key)
+	if err != nil {
+		return "", err
+	}
+
+	if !token.Valid() {
+		if token.RefreshToken == "" {
+			return "", ErrTokenExpired
+		}
+
+		token, err = source.config.Refresh(ctx, token.RefreshToken)
+		if err != nil {
+			return "", err
+		}
+
+		if err := source.store.Save(ctx, source.key, token); err != nil {
+			return "", err
+		}
+	}
+
+	source.token = token
+
+	return token.AccessToken, nil
+}
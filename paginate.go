@@ -1,5 +1,10 @@
 package aircall
 
+import (
+	"context"
+	"iter"
+)
+
 type GenericResponseMeta struct {
 	Count            int    `json:"count,omitempty"`
 	Total            int    `json:"total,omitempty"`
@@ -7,4 +12,58 @@ type GenericResponseMeta struct {
 	PerPage          int    `json:"per_page,omitempty"`
 	NextPageLink     string `json:"next_page_link,omitempty"`
 	PreviousPageLink string `json:"previous_page_link,omitempty"`
-}
\ No newline at end of file
+}
+
+// paginate iterates over the items of a list endpoint, following
+// 'next_page_link' until the last page, an error, or 'maxItems' (if set)
+func paginate[R any, T any](ctx context.Context, client *Client, url string, opts interface{}, maxItems []int, page func(*R) (*GenericResponseMeta, *[]T)) iter.Seq2[T, error] {
+	limit := 0
+
+	if len(maxItems) > 0 {
+		limit = maxItems[0]
+	}
+
+	return func(yield func(T, error) bool) {
+		count := 0
+
+		// Copies, so the iterator can be ranged over again from the start
+		next, query := url, opts
+
+		for next != "" {
+			responseBody := new(R)
+
+			_, err := client.GetContext(ctx, next, query, responseBody)
+			if err != nil {
+				var zero T
+
+				yield(zero, err)
+				return
+			}
+
+			meta, items := page(responseBody)
+
+			if items != nil {
+				for _, item := range *items {
+					if limit > 0 && count >= limit {
+						return
+					}
+
+					if !yield(item, nil) {
+						return
+					}
+
+					count++
+				}
+			}
+
+			// Cap reached on a page boundary? (do not fetch the next page)
+			if meta == nil || (limit > 0 && count >= limit) {
+				return
+			}
+
+			// Next page link already holds the query parameters
+			next = meta.NextPageLink
+			query = nil
+		}
+	}
+}
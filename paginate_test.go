@@ -0,0 +1,85 @@
+package aircall_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dinistavares/go-aircall-api/aircalltest"
+)
+
+func TestAllStopsAtMaxItems(t *testing.T) {
+	tests := []struct {
+		name         string
+		maxItems     int
+		wantItems    int
+		wantRequests int
+	}{
+		{"within a page", 5, 5, 1},
+		{"on a page boundary", 20, 20, 1},
+		{"across pages", 30, 30, 2},
+		{"unlimited", 0, 45, 3},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := aircalltest.NewServer()
+			defer server.Close()
+
+			server.SeedCalls(45, time.Now().Add(-time.Hour), time.Minute)
+
+			client := server.Client()
+
+			opts := client.Call.Query().NewListCalls()
+			opts.Paginate(1, 20)
+
+			items := 0
+
+			for _, err := range client.Call.All(context.Background(), opts, test.maxItems) {
+				if err != nil {
+					t.Fatalf("All() error = %v", err)
+				}
+
+				items++
+			}
+
+			if items != test.wantItems {
+				t.Errorf("All() yielded %d calls, want %d", items, test.wantItems)
+			}
+
+			if server.Requests() != test.wantRequests {
+				t.Errorf("All() sent %d requests, want %d", server.Requests(), test.wantRequests)
+			}
+		})
+	}
+}
+
+func TestAllRangesTwice(t *testing.T) {
+	server := aircalltest.NewServer()
+	defer server.Close()
+
+	server.SeedCalls(30, time.Now().Add(-time.Hour), time.Minute)
+
+	client := server.Client()
+
+	opts := client.Call.Query().NewListCalls()
+	opts.Paginate(1, 20)
+
+	calls := client.Call.All(context.Background(), opts)
+
+	for run := 1; run <= 2; run++ {
+		items := 0
+
+		for _, err := range calls {
+			if err != nil {
+				t.Fatalf("All() error = %v", err)
+			}
+
+			items++
+		}
+
+		if items != 30 {
+			t.Errorf("run %d yielded %d calls, want 30", run, items)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+package aircall
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	errorPoolClosed        = errors.New("client pool is closed")
+	errorPoolMissingTenant = errors.New("tenant is required")
+)
+
+type ClientPoolConfig struct {
+	// Template of the configuration of each tenant client. Its HTTP client
+	// (and transport) is shared by all tenants.
+	ClientConfig ClientConfig
+
+	// Provides the token source of a tenant (eg. from oauth.Config). Tenant
+	// clients are left unauthenticated when nil.
+	TokenSource func(tenant string) (TokenSource, error)
+
+	// Per tenant rate limit. Defaults to the Aircall company quota; set
+	// 'DisableRateLimit' to turn it off.
+	RateLimitRequests int
+	RateLimitPeriod   time.Duration
+	DisableRateLimit  bool
+
+	// Tenants unused for this long are evicted. Zero keeps them forever.
+	IdleTimeout time.Duration
+
+	// Registered on every tenant client
+	Middlewares []Middleware
+}
+
+// ClientPoolStats holds pool-wide counters.
+type ClientPoolStats struct {
+	Tenants  int
+	Created  int64
+	Evicted  int64
+	Requests int64
+	Errors   int64
+}
+
+// ClientPool lazily creates and caches one Client per tenant (eg. per Aircall
+// company), sharing a single HTTP transport.
+type ClientPool struct {
+	mutex   sync.Mutex
+	config  ClientPoolConfig
+	tenants map[string]*poolTenant
+	closed  bool
+	stop    chan struct{}
+
+	created  atomic.Int64
+	evicted  atomic.Int64
+	requests atomic.Int64
+	errors   atomic.Int64
+}
+
+type poolTenant struct {
+	client   *Client
+	lastUsed atomic.Int64
+
+	// Closed once the client is built, or failed to ('err')
+	ready chan struct{}
+	err   error
+}
+
+func (tenant *poolTenant) touch() {
+	tenant.lastUsed.Store(time.Now().UnixNano())
+}
+
+func NewClientPool(config ClientPoolConfig) *ClientPool {
+	// Share one HTTP client between tenants
+	if config.ClientConfig.HttpClient == nil {
+		timeout := config.ClientConfig.Timeout
+
+		if timeout <= 0 {
+			timeout = clientTimeout * time.Second
+		}
+
+		config.ClientConfig.HttpClient = &http.Client{Timeout: timeout}
+	}
+
+	pool := &ClientPool{config: config, tenants: map[string]*poolTenant{}, stop: make(chan struct{})}
+
+	if config.IdleTimeout > 0 {
+		go pool.evictIdleLoop()
+	}
+
+	return pool
+}
+
+// Get returns the client of the tenant, creating it on first use. Tenants are
+// created outside the pool lock, concurrent calls for a tenant being created
+// wait for it.
+func (pool *ClientPool) Get(tenant string) (*Client, error) {
+	if tenant == "" {
+		return nil, errorPoolMissingTenant
+	}
+
+	pool.mutex.Lock()
+
+	if pool.closed {
+		pool.mutex.Unlock()
+		return nil, errorPoolClosed
+	}
+
+	if entry, ok := pool.tenants[tenant]; ok {
+		pool.mutex.Unlock()
+
+		<-entry.ready
+
+		if entry.err != nil {
+			return nil, entry.err
+		}
+
+		entry.touch()
+
+		return entry.client, nil
+	}
+
+	entry := &poolTenant{ready: make(chan struct{})}
+	entry.touch()
+
+	pool.tenants[tenant] = entry
+	pool.mutex.Unlock()
+
+	entry.err = pool.newTenant(tenant, entry)
+	close(entry.ready)
+
+	if entry.err != nil {
+		// Forget the failed tenant so the next call retries
+		pool.mutex.Lock()
+
+		if pool.tenants[tenant] == entry {
+			delete(pool.tenants, tenant)
+		}
+
+		pool.mutex.Unlock()
+
+		return nil, entry.err
+	}
+
+	pool.created.Add(1)
+
+	return entry.client, nil
+}
+
+// newTenant builds the client of the tenant entry
+func (pool *ClientPool) newTenant(tenant string, entry *poolTenant) error {
+	config := pool.config.ClientConfig
+
+	if !pool.config.DisableRateLimit {
+		config.RateLimiter = NewRateLimiter(pool.config.RateLimitRequests, pool.config.RateLimitPeriod)
+	}
+
+	client := NewWithConfig(config)
+
+	if pool.config.TokenSource != nil {
+		source, err := pool.config.TokenSource(tenant)
+		if err != nil {
+			return err
+		}
+
+		client.AuthenticateTokenSource(source)
+	}
+
+	// Count requests and keep the tenant alive while in use
+	client.Use(func(next Handler) Handler {
+		return func(op *Operation) (*Response, error) {
+			entry.touch()
+			pool.requests.Add(1)
+
+			response, err := next(op)
+			if err != nil {
+				pool.errors.Add(1)
+			}
+
+			return response, err
+		}
+	})
+
+	client.Use(pool.config.Middlewares...)
+
+	entry.client = client
+
+	return nil
+}
+
+// Evict removes the tenant client from the pool.
+func (pool *ClientPool) Evict(tenant string) {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	if _, ok := pool.tenants[tenant]; ok {
+		delete(pool.tenants, tenant)
+		pool.evicted.Add(1)
+	}
+}
+
+// EvictIdle removes tenants unused for longer than the idle timeout and
+// returns how many were removed.
+func (pool *ClientPool) EvictIdle() int {
+	if pool.config.IdleTimeout <= 0 {
+		return 0
+	}
+
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	deadline := time.Now().Add(-pool.config.IdleTimeout).UnixNano()
+	count := 0
+
+	for tenant, entry := range pool.tenants {
+		if entry.lastUsed.Load() < deadline {
+			delete(pool.tenants, tenant)
+			count++
+		}
+	}
+
+	pool.evicted.Add(int64(count))
+
+	return count
+}
+
+func (pool *ClientPool) evictIdleLoop() {
+	ticker := time.NewTicker(pool.config.IdleTimeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-pool.stop:
+			return
+		case <-ticker.C:
+			pool.EvictIdle()
+		}
+	}
+}
+
+// Stats returns pool-wide counters.
+func (pool *ClientPool) Stats() ClientPoolStats {
+	pool.mutex.Lock()
+	tenants := len(pool.tenants)
+	pool.mutex.Unlock()
+
+	return ClientPoolStats{
+		Tenants:  tenants,
+		Created:  pool.created.Load(),
+		Evicted:  pool.evicted.Load(),
+		Requests: pool.requests.Load(),
+		Errors:   pool.errors.Load(),
+	}
+}
+
+// Close evicts all tenants and stops background eviction.
+func (pool *ClientPool) Close() {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	if pool.closed {
+		return
+	}
+
+	pool.closed = true
+	pool.evicted.Add(int64(len(pool.tenants)))
+	pool.tenants = map[string]*poolTenant{}
+
+	close(pool.stop)
+}
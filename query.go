@@ -2,57 +2,185 @@ package aircall
 
 import (
 	"fmt"
+	"net/url"
 	"reflect"
+	"strconv"
+	"strings"
+	"time"
 )
 
-type QueryValues map[string]string
+const (
+	queryMinPerPage = 1
+	queryMaxPerPage = 50
+)
+
+// Sort order of list endpoints ('order' query parameter).
+type SortOrder string
+
+const (
+	SortAscending  SortOrder = "asc"
+	SortDescending SortOrder = "desc"
+)
+
+func (order SortOrder) IsValid() bool {
+	return order == SortAscending || order == SortDescending
+}
+
+// Sort field of contact endpoints ('order_by' query parameter).
+type ContactOrderBy string
+
+const (
+	ContactOrderByCreatedAt ContactOrderBy = "created_at"
+	ContactOrderByUpdatedAt ContactOrderBy = "updated_at"
+)
+
+func (orderBy ContactOrderBy) IsValid() bool {
+	return orderBy == ContactOrderByCreatedAt || orderBy == ContactOrderByUpdatedAt
+}
+
+// QueryValidationError is returned before sending a request with invalid
+// query parameters. It matches ErrValidation.
+type QueryValidationError struct {
+	Key    string
+	Value  string
+	Reason string
+}
+
+func (e *QueryValidationError) Error() string {
+	return fmt.Sprintf("invalid query parameter '%s=%s': %s", e.Key, e.Value, e.Reason)
+}
+
+func (e *QueryValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// QueryValues holds query parameters with net/url.Values semantics: encoding
+// is escaped and sorted by key.
+type QueryValues url.Values
 
 func (v *QueryValues) getQueryValues() QueryValues {
 	return *v
 }
 
+// encode returns the escaped query string, sorted by key (eg. "?a=1&b=2")
 func (v QueryValues) encode() string {
-	var query string
-
-	count := 0
-
-	for key, value := range v {
-		if count > 0 {
-			query += "&"
-		} else {
-			query = "?"
-		}
+	if len(v) == 0 {
+		return ""
+	}
 
-		query += key + "=" + value
+	return "?" + url.Values(v).Encode()
+}
 
-		count++
+// CanonicalQuery returns a raw query string sorted by key and consistently
+// escaped, so equivalent queries compare equal (eg. "b=2&a=1" and "a=1&b=2").
+func CanonicalQuery(rawQuery string) string {
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return rawQuery
 	}
 
-	return query
+	return strings.TrimPrefix(QueryValues(values).encode(), "?")
 }
 
 func (v QueryValues) from(value string) {
-	v["from"] = value
+	v.set("from", value)
 }
 
 func (v QueryValues) to(value string) {
-	v["to"] = value
+	v.set("to", value)
+}
+
+func (v QueryValues) fromTime(value time.Time) {
+	v.from(strconv.FormatInt(value.Unix(), 10))
+}
+
+func (v QueryValues) toTime(value time.Time) {
+	v.to(strconv.FormatInt(value.Unix(), 10))
 }
 
 func (v QueryValues) order(value string) {
-	v["order"] = value
+	v.set("order", value)
 }
 
 func (v QueryValues) orderBy(value string) {
+	v.set("order_by", value)
 }
 
 func (v QueryValues) page(page int, perPage int) {
-	v["page"] = fmt.Sprintf("%d", page)
-	v["per_page"] = fmt.Sprintf("%d", perPage)
+	v.set("page", fmt.Sprintf("%d", page))
+	v.set("per_page", fmt.Sprintf("%d", perPage))
+}
+
+func (v QueryValues) get(key string) string {
+	return url.Values(v).Get(key)
 }
 
 func (v QueryValues) set(key string, value string) {
-	v[key] = value
+	url.Values(v).Set(key, value)
+}
+
+func (v QueryValues) clone() QueryValues {
+	values := QueryValues{}
+
+	for key, value := range v {
+		values[key] = append([]string(nil), value...)
+	}
+
+	return values
+}
+
+// validate checks the query parameters before they are sent
+func (v QueryValues) validate() error {
+	var from, to int64
+
+	for _, key := range []string{"from", "to"} {
+		value := v.get(key)
+
+		if value == "" {
+			continue
+		}
+
+		timestamp, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return &QueryValidationError{Key: key, Value: value, Reason: "must be a UNIX timestamp"}
+		}
+
+		if key == "from" {
+			from = timestamp
+		} else {
+			to = timestamp
+		}
+	}
+
+	if from > 0 && to > 0 && from > to {
+		return &QueryValidationError{Key: "from", Value: v.get("from"), Reason: "must not be after 'to'"}
+	}
+
+	if value := v.get("order"); value != "" && !SortOrder(value).IsValid() {
+		return &QueryValidationError{Key: "order", Value: value, Reason: "must be 'asc' or 'desc'"}
+	}
+
+	if value := v.get("order_by"); value != "" && !ContactOrderBy(value).IsValid() {
+		return &QueryValidationError{Key: "order_by", Value: value, Reason: "must be 'created_at' or 'updated_at'"}
+	}
+
+	if value := v.get("direction"); value != "" && !CallDirection(value).IsValid() {
+		return &QueryValidationError{Key: "direction", Value: value, Reason: "must be 'inbound' or 'outbound'"}
+	}
+
+	if value := v.get("page"); value != "" {
+		if page, err := strconv.Atoi(value); err != nil || page < 1 {
+			return &QueryValidationError{Key: "page", Value: value, Reason: "must be a positive number"}
+		}
+	}
+
+	if value := v.get("per_page"); value != "" {
+		if perPage, err := strconv.Atoi(value); err != nil || perPage < queryMinPerPage || perPage > queryMaxPerPage {
+			return &QueryValidationError{Key: "per_page", Value: value, Reason: fmt.Sprintf("must be between %d and %d", queryMinPerPage, queryMaxPerPage)}
+		}
+	}
+
+	return nil
 }
 
 func isPointerWithQueryValues(i interface{}) (interface{}, bool) {
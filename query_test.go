@@ -0,0 +1,94 @@
+package aircall
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestQueryValuesEncoding(t *testing.T) {
+	client := New()
+	queries := client.Contact.Query()
+
+	tests := []struct {
+		name  string
+		opts  func() *SearchContactsQueryParams
+		url   string
+		query string
+	}{
+		{
+			name: "phone number plus sign",
+			opts: func() *SearchContactsQueryParams {
+				opts := queries.NewSearchContacts()
+				opts.PhoneNumber("+33 1 23 45 67 89")
+				return opts
+			},
+			url:   "contacts/search",
+			query: "phone_number=%2B33+1+23+45+67+89",
+		},
+		{
+			name: "email at sign",
+			opts: func() *SearchContactsQueryParams {
+				opts := queries.NewSearchContacts()
+				opts.Email("ada+test@example.com")
+				return opts
+			},
+			url:   "contacts/search",
+			query: "email=ada%2Btest%40example.com",
+		},
+		{
+			name: "sorted keys and order_by",
+			opts: func() *SearchContactsQueryParams {
+				opts := queries.NewSearchContacts()
+				opts.OrderBy(ContactOrderByUpdatedAt)
+				opts.Order(SortDescending)
+				opts.Paginate(2, 50)
+				opts.From("1717171200")
+				return opts
+			},
+			url:   "contacts/search",
+			query: "from=1717171200&order=desc&order_by=updated_at&page=2&per_page=50",
+		},
+		{
+			name: "merged with the URL query",
+			opts: func() *SearchContactsQueryParams {
+				opts := queries.NewSearchContacts()
+				opts.Email("ada@example.com")
+				return opts
+			},
+			url:   "contacts/search?page=3",
+			query: "email=ada%40example.com&page=3",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Encoding must not depend on map iteration order
+			for i := 0; i < 10; i++ {
+				req, err := client.NewRequest(http.MethodGet, test.url, test.opts(), nil)
+				if err != nil {
+					t.Fatalf("NewRequest() error = %v", err)
+				}
+
+				if req.URL.RawQuery != test.query {
+					t.Fatalf("RawQuery = %q, want %q", req.URL.RawQuery, test.query)
+				}
+			}
+		})
+	}
+}
+
+func TestQueryValuesValidation(t *testing.T) {
+	client := New()
+
+	opts := client.Contact.Query().NewSearchContacts()
+	opts.OrderBy("name")
+
+	_, err := client.NewRequest(http.MethodGet, "contacts/search", opts, nil)
+
+	var validationErr *QueryValidationError
+
+	if !errors.Is(err, ErrValidation) || !errors.As(err, &validationErr) || validationErr.Key != "order_by" {
+		t.Errorf("NewRequest() error = %v, want an order_by validation error", err)
+	}
+}
@@ -0,0 +1,159 @@
+package aircall
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	headerAircallApiLimit     = "X-AircallApi-Limit"
+	headerAircallApiRemaining = "X-AircallApi-Remaining"
+
+	// Aircall default company quota
+	DefaultRateLimitRequests = 60
+	DefaultRateLimitPeriod   = time.Minute
+)
+
+// RateLimit holds the rate limit state reported by Aircall on a response.
+type RateLimit struct {
+	// Maximum number of requests allowed in the current window.
+	Limit int
+
+	// Number of requests left in the current window.
+	Remaining int
+
+	// Time at which the current window resets.
+	Reset time.Time
+}
+
+// IsZero reports whether no rate limit headers were present
+func (rateLimit RateLimit) IsZero() bool {
+	return rateLimit.Limit == 0 && rateLimit.Remaining == 0 && rateLimit.Reset.IsZero()
+}
+
+// parseRateLimit reads the Aircall rate limit headers
+func parseRateLimit(header http.Header) RateLimit {
+	rateLimit := RateLimit{}
+
+	if value, err := strconv.Atoi(header.Get(headerAircallApiLimit)); err == nil {
+		rateLimit.Limit = value
+	}
+
+	if value, err := strconv.Atoi(header.Get(headerAircallApiRemaining)); err == nil {
+		rateLimit.Remaining = value
+	}
+
+	if value, err := strconv.ParseInt(header.Get(headerAircallApiReset), 10, 64); err == nil {
+		rateLimit.Reset = time.Unix(value, 0)
+	}
+
+	return rateLimit
+}
+
+// RateLimiter is a token bucket limiter pacing requests on the client side.
+// A single limiter can be shared by several clients using the same quota.
+type RateLimiter struct {
+	mutex sync.Mutex
+
+	capacity float64
+	tokens   float64
+	interval time.Duration
+	last     time.Time
+
+	// Set when Aircall reports the quota is exhausted
+	blockedUntil time.Time
+}
+
+// NewRateLimiter creates a limiter allowing 'requests' per 'period', with
+// bursts up to 'requests'.
+func NewRateLimiter(requests int, period time.Duration) *RateLimiter {
+	if requests <= 0 {
+		requests = DefaultRateLimitRequests
+	}
+
+	if period <= 0 {
+		period = DefaultRateLimitPeriod
+	}
+
+	return &RateLimiter{
+		capacity: float64(requests),
+		tokens:   float64(requests),
+		interval: period / time.Duration(requests),
+		last:     time.Now(),
+	}
+}
+
+// NewDefaultRateLimiter creates a limiter matching the Aircall company quota.
+func NewDefaultRateLimiter() *RateLimiter {
+	return NewRateLimiter(DefaultRateLimitRequests, DefaultRateLimitPeriod)
+}
+
+// Wait blocks until a request may be sent, or until the context is done.
+func (limiter *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		wait := limiter.reserve(time.Now())
+
+		if wait <= 0 {
+			return nil
+		}
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token, or returns how long to wait for one
+func (limiter *RateLimiter) reserve(now time.Time) time.Duration {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	if now.Before(limiter.blockedUntil) {
+		return limiter.blockedUntil.Sub(now)
+	}
+
+	// Refill tokens for the time elapsed since last reservation
+	if elapsed := now.Sub(limiter.last); elapsed > 0 {
+		limiter.tokens += float64(elapsed) / float64(limiter.interval)
+
+		if limiter.tokens > limiter.capacity {
+			limiter.tokens = limiter.capacity
+		}
+
+		limiter.last = now
+	}
+
+	if limiter.tokens >= 1 {
+		limiter.tokens--
+
+		return 0
+	}
+
+	return time.Duration((1 - limiter.tokens) * float64(limiter.interval))
+}
+
+// observe aligns the limiter with the rate limit reported by Aircall
+func (limiter *RateLimiter) observe(rateLimit RateLimit, now time.Time) {
+	if rateLimit.IsZero() {
+		return
+	}
+
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	// Quota exhausted server side? (hold everyone until the window resets)
+	if rateLimit.Remaining <= 0 && rateLimit.Reset.After(now) {
+		limiter.blockedUntil = rateLimit.Reset
+		limiter.tokens = 0
+
+		return
+	}
+
+	// Never assume more tokens than the server has left
+	if remaining := float64(rateLimit.Remaining); remaining < limiter.tokens {
+		limiter.tokens = remaining
+	}
+}
@@ -0,0 +1,185 @@
+package aircall
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	headerRetryAfter         = "Retry-After"
+	headerAircallApiReset    = "X-AircallApi-Reset"
+	defaultRetryMaxBackoff   = 30 * time.Second
+	defaultRetryJitterFactor = 0.5
+)
+
+// RetryPolicy controls how the client retries failed requests.
+type RetryPolicy struct {
+	// Maximum number of attempts per request, including the first one.
+	MaxAttempts int
+
+	// Backoff before the first retry. Doubles after each attempt.
+	MinBackoff time.Duration
+
+	// Upper bound for the backoff, including holds requested by the server.
+	MaxBackoff time.Duration
+
+	// Fraction (0 to 1) of the backoff that is randomized.
+	Jitter float64
+
+	// Honor 'Retry-After' and 'X-AircallApi-Reset' headers when present.
+	RespectRetryAfter bool
+
+	// HTTP status codes that should be retried. When empty, HTTP 429 and
+	// every HTTP 5xx are retried.
+	RetryableStatusCodes []int
+
+	// Retry non-idempotent requests (ie. POST) after network errors and
+	// server errors. They may have been processed already, so by default
+	// they are only retried when rate limited (HTTP 429).
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns the retry policy used when none is configured.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:       clientRequestRetryAttempts,
+		MinBackoff:        clientRequestRetryHoldMillis * time.Millisecond,
+		MaxBackoff:        defaultRetryMaxBackoff,
+		Jitter:            defaultRetryJitterFactor,
+		RespectRetryAfter: true,
+	}
+}
+
+// withDefaults fills unset fields with the default policy values
+func (policy RetryPolicy) withDefaults() *RetryPolicy {
+	defaults := DefaultRetryPolicy()
+
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = defaults.MaxAttempts
+	}
+
+	if policy.MinBackoff <= 0 {
+		policy.MinBackoff = defaults.MinBackoff
+	}
+
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = defaults.MaxBackoff
+	}
+
+	if policy.MaxBackoff < policy.MinBackoff {
+		policy.MaxBackoff = policy.MinBackoff
+	}
+
+	policy.Jitter = math.Max(0, math.Min(1, policy.Jitter))
+
+	return &policy
+}
+
+// shouldRetry checks if should retry request
+func (policy *RetryPolicy) shouldRetry(request *http.Request, response *http.Response, err error) bool {
+	// Request context done? (retrying cannot succeed)
+	if request.Context().Err() != nil {
+		return false
+	}
+
+	// Rate limited requests were rejected before being processed
+	if err == nil && response.StatusCode == http.StatusTooManyRequests {
+		return policy.isRetryableStatus(response.StatusCode)
+	}
+
+	if !policy.RetryNonIdempotent && !isIdempotent(request.Method) {
+		return false
+	}
+
+	// Low-level error? (eg. connection reset)
+	if err != nil {
+		return true
+	}
+
+	return policy.isRetryableStatus(response.StatusCode)
+}
+
+// isIdempotent checks if the HTTP method can safely be sent twice
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// isRetryableStatus checks if the response status should be retried
+func (policy *RetryPolicy) isRetryableStatus(statusCode int) bool {
+	if len(policy.RetryableStatusCodes) == 0 {
+		return statusCode == http.StatusTooManyRequests || statusCode >= 500
+	}
+
+	for _, code := range policy.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff computes the hold before the given retry (1 for the first retry)
+func (policy *RetryPolicy) backoff(retry int, response *http.Response) time.Duration {
+	// Server told us when to come back? (eg. rate limited)
+	if policy.RespectRetryAfter && response != nil {
+		if wait, ok := retryAfter(response, time.Now()); ok {
+			return min(wait, policy.MaxBackoff)
+		}
+	}
+
+	wait := float64(policy.MinBackoff) * math.Pow(2, float64(retry-1))
+	wait = math.Min(wait, float64(policy.MaxBackoff))
+
+	// Randomize part of the hold so concurrent clients spread out
+	if policy.Jitter > 0 {
+		jitter := wait * policy.Jitter
+		wait = wait - jitter + rand.Float64()*jitter
+	}
+
+	return time.Duration(wait)
+}
+
+// retryAfter reads the hold requested by the server, if any
+func retryAfter(response *http.Response, now time.Time) (time.Duration, bool) {
+	header := response.Header
+
+	if value := header.Get(headerRetryAfter); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+
+		if date, err := http.ParseTime(value); err == nil {
+			return nonNegative(date.Sub(now)), true
+		}
+	}
+
+	// Rate limited: Aircall sends the unix timestamp at which the limit resets
+	if response.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	if value := header.Get(headerAircallApiReset); value != "" {
+		if reset, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return nonNegative(time.Unix(reset, 0).Sub(now)), true
+		}
+	}
+
+	return 0, false
+}
+
+func nonNegative(duration time.Duration) time.Duration {
+	if duration < 0 {
+		return 0
+	}
+
+	return duration
+}
@@ -0,0 +1,178 @@
+package aircall
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// scriptedTransport answers requests with the scripted responses in order,
+// recording the request bodies it receives
+type scriptedTransport struct {
+	mutex  sync.Mutex
+	script []func(req *http.Request) (*http.Response, error)
+	bodies []string
+}
+
+func (transport *scriptedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport.mutex.Lock()
+	defer transport.mutex.Unlock()
+
+	body := ""
+
+	if req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		req.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		body = string(data)
+	}
+
+	attempt := len(transport.bodies)
+	transport.bodies = append(transport.bodies, body)
+
+	if attempt >= len(transport.script) {
+		return nil, errors.New("unexpected request")
+	}
+
+	return transport.script[attempt](req)
+}
+
+func respondWith(statusCode int, body string) func(req *http.Request) (*http.Response, error) {
+	return func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: statusCode,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    req,
+		}, nil
+	}
+}
+
+func failWith(err error) func(req *http.Request) (*http.Response, error) {
+	return func(req *http.Request) (*http.Response, error) {
+		return nil, err
+	}
+}
+
+func newScriptedClient(transport *scriptedTransport, policy RetryPolicy) *Client {
+	policy.MaxAttempts = 3
+	policy.MinBackoff = time.Millisecond
+	policy.MaxBackoff = time.Millisecond
+
+	client := NewWithConfig(ClientConfig{
+		HttpClient:  &http.Client{Transport: transport},
+		RetryPolicy: &policy,
+	})
+
+	client.Authenticate("token")
+
+	return client
+}
+
+func TestRetryResendsBodyAfterRateLimit(t *testing.T) {
+	transport := &scriptedTransport{script: []func(req *http.Request) (*http.Response, error){
+		respondWith(http.StatusTooManyRequests, `{"error":"Too many requests"}`),
+		respondWith(http.StatusCreated, `{"contact":{"id":1,"first_name":"Ada"}}`),
+	}}
+
+	client := newScriptedClient(transport, RetryPolicy{})
+
+	response, _, err := client.Contact.Create(&CreateUpdateContact{FirstName: "Ada", LastName: "Lovelace"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if response.Contact == nil || response.Contact.ID != 1 {
+		t.Fatalf("Create() contact = %+v, want ID 1", response.Contact)
+	}
+
+	if len(transport.bodies) != 2 {
+		t.Fatalf("got %d attempts, want 2", len(transport.bodies))
+	}
+
+	if transport.bodies[0] == "" || transport.bodies[1] != transport.bodies[0] {
+		t.Errorf("retried body = %q, want %q", transport.bodies[1], transport.bodies[0])
+	}
+}
+
+func TestRetryNonIdempotent(t *testing.T) {
+	tests := []struct {
+		name               string
+		first              func(req *http.Request) (*http.Response, error)
+		retryNonIdempotent bool
+		wantAttempts       int
+	}{
+		{"server error", respondWith(http.StatusBadGateway, `{"error":"Bad gateway"}`), false, 1},
+		{"network error", failWith(errors.New("connection reset")), false, 1},
+		{"server error allowed", respondWith(http.StatusBadGateway, `{"error":"Bad gateway"}`), true, 2},
+		{"network error allowed", failWith(errors.New("connection reset")), true, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			transport := &scriptedTransport{script: []func(req *http.Request) (*http.Response, error){
+				test.first,
+				respondWith(http.StatusCreated, `{"contact":{"id":1}}`),
+			}}
+
+			client := newScriptedClient(transport, RetryPolicy{RetryNonIdempotent: test.retryNonIdempotent})
+
+			_, _, err := client.Contact.Create(&CreateUpdateContact{FirstName: "Ada"})
+
+			if len(transport.bodies) != test.wantAttempts {
+				t.Fatalf("got %d attempts, want %d (error %v)", len(transport.bodies), test.wantAttempts, err)
+			}
+
+			if test.wantAttempts == 1 && err == nil {
+				t.Errorf("Create() error = nil, want the first attempt error")
+			}
+
+			if test.wantAttempts > 1 {
+				if err != nil {
+					t.Errorf("Create() error = %v", err)
+				}
+
+				if transport.bodies[1] != transport.bodies[0] {
+					t.Errorf("retried body = %q, want %q", transport.bodies[1], transport.bodies[0])
+				}
+			}
+		})
+	}
+}
+
+func TestRetryBackoffCapsServerHold(t *testing.T) {
+	policy := RetryPolicy{MinBackoff: time.Second, MaxBackoff: 30 * time.Second, RespectRetryAfter: true}
+
+	tests := []struct {
+		name   string
+		status int
+		header string
+		value  string
+		want   time.Duration
+	}{
+		{"short retry after", http.StatusServiceUnavailable, headerRetryAfter, "5", 5 * time.Second},
+		{"long retry after", http.StatusServiceUnavailable, headerRetryAfter, "3600", 30 * time.Second},
+		{"distant reset", http.StatusTooManyRequests, headerAircallApiReset, strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10), 30 * time.Second},
+		{"past reset", http.StatusTooManyRequests, headerAircallApiReset, "1", 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response := &http.Response{StatusCode: test.status, Header: http.Header{}}
+			response.Header.Set(test.header, test.value)
+
+			if wait := policy.backoff(1, response); wait != test.want {
+				t.Errorf("backoff() = %v, want %v", wait, test.want)
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package aircall
 
 import (
+	"context"
 	"fmt"
+	"iter"
 )
 
 // Tag service
@@ -60,10 +62,17 @@ func (p ListTagsQueryParams) Paginate(page int, perPage int) {
 
 // List tags. Reference: https://developer.aircall.io/api-references/#list-all-tags
 func (service *TagsService) List(opts *ListTagsQueryParams) (*TagsResponse, *Response, error) {
+	return service.ListContext(context.Background(), opts)
+}
+
+// List tags using the given context. Reference: https://developer.aircall.io/api-references/#list-all-tags
+func (service *TagsService) ListContext(ctx context.Context, opts *ListTagsQueryParams) (*TagsResponse, *Response, error) {
+	ctx = withOperation(ctx, "Tag.List")
+
 	_url := "tags"
 
 	responseBody := new(TagsResponse)
-	response, err := service.client.Get(_url, opts, responseBody)
+	response, err := service.client.GetContext(ctx, _url, opts, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -72,6 +81,18 @@ func (service *TagsService) List(opts *ListTagsQueryParams) (*TagsResponse, *Res
 	return responseBody, response, nil
 }
 
+// Iterate over all tags, following pagination. An optional cap on the number
+// of items can be given. Reference: https://developer.aircall.io/api-references/#list-all-tags
+func (service *TagsService) All(ctx context.Context, opts *ListTagsQueryParams, maxItems ...int) iter.Seq2[Tag, error] {
+	ctx = withOperation(ctx, "Tag.All")
+
+	_url := "tags"
+
+	return paginate(ctx, service.client, _url, opts, maxItems, func(page *TagsResponse) (*GenericResponseMeta, *[]Tag) {
+		return page.Meta, page.Tags
+	})
+}
+
 //  ***********************************************************************************
 //  GET TAG
 //  https://developer.aircall.io/api-references/#retrieve-a-tag
@@ -79,10 +100,17 @@ func (service *TagsService) List(opts *ListTagsQueryParams) (*TagsResponse, *Res
 
 // Get a tag. Reference: https://developer.aircall.io/api-references/#retrieve-a-tag
 func (service *TagsService) Get(tagID int) (*TagResponse, *Response, error) {
+	return service.GetContext(context.Background(), tagID)
+}
+
+// Get a tag using the given context. Reference: https://developer.aircall.io/api-references/#retrieve-a-tag
+func (service *TagsService) GetContext(ctx context.Context, tagID int) (*TagResponse, *Response, error) {
+	ctx = withOperation(ctx, "Tag.Get")
+
 	_url := fmt.Sprintf("tags/%d", tagID)
 
 	responseBody := new(TagResponse)
-	response, err := service.client.Get(_url, nil, responseBody)
+	response, err := service.client.GetContext(ctx, _url, nil, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -98,10 +126,17 @@ func (service *TagsService) Get(tagID int) (*TagResponse, *Response, error) {
 
 // Create a tag. Reference: https://developer.aircall.io/api-references/#create-a-tag
 func (service *TagsService) Create(tag *CreateUpdateTag) (*TagResponse, *Response, error) {
+	return service.CreateContext(context.Background(), tag)
+}
+
+// Create a tag using the given context. Reference: https://developer.aircall.io/api-references/#create-a-tag
+func (service *TagsService) CreateContext(ctx context.Context, tag *CreateUpdateTag) (*TagResponse, *Response, error) {
+	ctx = withOperation(ctx, "Tag.Create")
+
 	_url := "tags"
 
 	responseBody := new(TagResponse)
-	response, err := service.client.Post(_url, tag, responseBody)
+	response, err := service.client.PostContext(ctx, _url, tag, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -117,11 +152,17 @@ func (service *TagsService) Create(tag *CreateUpdateTag) (*TagResponse, *Respons
 
 // Update a tag. Reference: https://developer.aircall.io/api-references/#update-a-tag
 func (service *TagsService) Update(tagID int, tag *CreateUpdateTag) (*TagResponse, *Response, error) {
+	return service.UpdateContext(context.Background(), tagID, tag)
+}
+
+// Update a tag using the given context. Reference: https://developer.aircall.io/api-references/#update-a-tag
+func (service *TagsService) UpdateContext(ctx context.Context, tagID int, tag *CreateUpdateTag) (*TagResponse, *Response, error) {
+	ctx = withOperation(ctx, "Tag.Update")
+
 	_url := fmt.Sprintf("tags/%d", tagID)
-	
 
 	responseBody := new(TagResponse)
-	response, err := service.client.Put(_url, tag, responseBody)
+	response, err := service.client.PutContext(ctx, _url, tag, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -137,7 +178,14 @@ func (service *TagsService) Update(tagID int, tag *CreateUpdateTag) (*TagRespons
 
 // Delete a tag. Reference: https://developer.aircall.io/api-references/#delete-a-tag
 func (service *TagsService) Delete(tagID int) (*Response, error) {
+	return service.DeleteContext(context.Background(), tagID)
+}
+
+// Delete a tag using the given context. Reference: https://developer.aircall.io/api-references/#delete-a-tag
+func (service *TagsService) DeleteContext(ctx context.Context, tagID int) (*Response, error) {
+	ctx = withOperation(ctx, "Tag.Delete")
+
 	_url := fmt.Sprintf("tags/%d", tagID)
 
-	return service.client.Delete(_url)
+	return service.client.DeleteContext(ctx, _url)
 }
@@ -1,6 +1,10 @@
 package aircall
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"iter"
+)
 
 // Team service
 type TeamsService service
@@ -15,11 +19,11 @@ type TeamResponse struct {
 }
 
 type Team struct {
-	ID         int         `json:"id,omitempty"`
-	DirectLink string      `json:"direct_link,omitempty"`
-	Name       string      `json:"name,omitempty"`
-	CreatedAt  interface{} `json:"created_at,omitempty"`
-	Users      *[]User     `json:"users,omitempty"`
+	ID         int        `json:"id,omitempty"`
+	DirectLink string     `json:"direct_link,omitempty"`
+	Name       string     `json:"name,omitempty"`
+	CreatedAt  *Timestamp `json:"created_at,omitempty"`
+	Users      *[]User    `json:"users,omitempty"`
 }
 
 type CreateTeam struct {
@@ -51,8 +55,8 @@ func (pq TeamQueries) NewListTeams() *ListTeamsQueryParams {
 }
 
 // Set 'order' for for 'List' method.
-func (p ListTeamsQueryParams) Order(value string) {
-	p.order(value)
+func (p ListTeamsQueryParams) Order(value SortOrder) {
+	p.order(string(value))
 }
 
 // Set 'page' and 'per_page' for for 'List' method.
@@ -62,10 +66,17 @@ func (p ListTeamsQueryParams) Paginate(page int, perPage int) {
 
 // List teams. Reference: https://developer.aircall.io/api-references/#list-all-teams
 func (service *TeamsService) List(opts *ListTeamsQueryParams) (*TeamsResponse, *Response, error) {
+	return service.ListContext(context.Background(), opts)
+}
+
+// List teams using the given context. Reference: https://developer.aircall.io/api-references/#list-all-teams
+func (service *TeamsService) ListContext(ctx context.Context, opts *ListTeamsQueryParams) (*TeamsResponse, *Response, error) {
+	ctx = withOperation(ctx, "Team.List")
+
 	_url := "teams"
 
 	responseBody := new(TeamsResponse)
-	response, err := service.client.Get(_url, opts, responseBody)
+	response, err := service.client.GetContext(ctx, _url, opts, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -74,6 +85,18 @@ func (service *TeamsService) List(opts *ListTeamsQueryParams) (*TeamsResponse, *
 	return responseBody, response, nil
 }
 
+// Iterate over all teams, following pagination. An optional cap on the number
+// of items can be given. Reference: https://developer.aircall.io/api-references/#list-all-teams
+func (service *TeamsService) All(ctx context.Context, opts *ListTeamsQueryParams, maxItems ...int) iter.Seq2[Team, error] {
+	ctx = withOperation(ctx, "Team.All")
+
+	_url := "teams"
+
+	return paginate(ctx, service.client, _url, opts, maxItems, func(page *TeamsResponse) (*GenericResponseMeta, *[]Team) {
+		return page.Meta, page.Teams
+	})
+}
+
 //  ***********************************************************************************
 //  GET TEAM
 //  https://developer.aircall.io/api-references/#retrieve-a-team
@@ -81,10 +104,17 @@ func (service *TeamsService) List(opts *ListTeamsQueryParams) (*TeamsResponse, *
 
 // Get a team. Reference: https://developer.aircall.io/api-references/#retrieve-a-team
 func (service *TeamsService) Get(teamID int) (*TeamResponse, *Response, error) {
+	return service.GetContext(context.Background(), teamID)
+}
+
+// Get a team using the given context. Reference: https://developer.aircall.io/api-references/#retrieve-a-team
+func (service *TeamsService) GetContext(ctx context.Context, teamID int) (*TeamResponse, *Response, error) {
+	ctx = withOperation(ctx, "Team.Get")
+
 	_url := fmt.Sprintf("teams/%d", teamID)
 
 	responseBody := new(TeamResponse)
-	response, err := service.client.Get(_url, nil, responseBody)
+	response, err := service.client.GetContext(ctx, _url, nil, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -100,10 +130,17 @@ func (service *TeamsService) Get(teamID int) (*TeamResponse, *Response, error) {
 
 // Create a team. Reference: https://developer.aircall.io/api-references/#create-a-team
 func (service *TeamsService) Create(team *CreateTeam) (*TeamResponse, *Response, error) {
+	return service.CreateContext(context.Background(), team)
+}
+
+// Create a team using the given context. Reference: https://developer.aircall.io/api-references/#create-a-team
+func (service *TeamsService) CreateContext(ctx context.Context, team *CreateTeam) (*TeamResponse, *Response, error) {
+	ctx = withOperation(ctx, "Team.Create")
+
 	_url := "teams"
 
 	responseBody := new(TeamResponse)
-	response, err := service.client.Post(_url, team, responseBody)
+	response, err := service.client.PostContext(ctx, _url, team, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -119,9 +156,16 @@ func (service *TeamsService) Create(team *CreateTeam) (*TeamResponse, *Response,
 
 // Delete a team. Reference: https://developer.aircall.io/api-references/#delete-a-team
 func (service *TeamsService) Delete(teamID int) (*Response, error) {
+	return service.DeleteContext(context.Background(), teamID)
+}
+
+// Delete a team using the given context. Reference: https://developer.aircall.io/api-references/#delete-a-team
+func (service *TeamsService) DeleteContext(ctx context.Context, teamID int) (*Response, error) {
+	ctx = withOperation(ctx, "Team.Delete")
+
 	_url := fmt.Sprintf("teams/%d", teamID)
 
-	return service.client.Delete(_url)
+	return service.client.DeleteContext(ctx, _url)
 }
 
 //  ***********************************************************************************
@@ -131,10 +175,17 @@ func (service *TeamsService) Delete(teamID int) (*Response, error) {
 
 // Add a user to a team. Reference: https://developer.aircall.io/api-references/#add-a-user-to-a-team
 func (service *TeamsService) AddUser(teamID int, userID int) (*TeamResponse, *Response, error) {
+	return service.AddUserContext(context.Background(), teamID, userID)
+}
+
+// Add a user to a team using the given context. Reference: https://developer.aircall.io/api-references/#add-a-user-to-a-team
+func (service *TeamsService) AddUserContext(ctx context.Context, teamID int, userID int) (*TeamResponse, *Response, error) {
+	ctx = withOperation(ctx, "Team.AddUser")
+
 	_url := fmt.Sprintf("teams/%d/users/%d", teamID, userID)
 
 	responseBody := new(TeamResponse)
-	response, err := service.client.Post(_url, userID, responseBody)
+	response, err := service.client.PostContext(ctx, _url, userID, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -150,10 +201,17 @@ func (service *TeamsService) AddUser(teamID int, userID int) (*TeamResponse, *Re
 
 // Remove a user from a team. Reference: https://developer.aircall.io/api-references/#remove-a-user-from-a-team
 func (service *TeamsService) RemoveUser(teamID int, userID int) (*TeamResponse, *Response, error) {
+	return service.RemoveUserContext(context.Background(), teamID, userID)
+}
+
+// Remove a user from a team using the given context. Reference: https://developer.aircall.io/api-references/#remove-a-user-from-a-team
+func (service *TeamsService) RemoveUserContext(ctx context.Context, teamID int, userID int) (*TeamResponse, *Response, error) {
+	ctx = withOperation(ctx, "Team.RemoveUser")
+
 	_url := fmt.Sprintf("teams/%d/users/%d", teamID, userID)
 
 	responseBody := new(TeamResponse)
-	response, err := service.client.Delete(_url, responseBody)
+	response, err := service.client.DeleteContext(ctx, _url, responseBody)
 
 	if err != nil {
 		return nil, response, err
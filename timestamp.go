@@ -0,0 +1,176 @@
+package aircall
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UNIX timestamps past this value are in milliseconds (in seconds, it would be
+// year 33658)
+const timestampMillisecondsThreshold = 1e12
+
+// Layouts of the string dates returned by Aircall
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.000Z0700",
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// Timestamp is a date returned by Aircall, either as a UNIX timestamp or as
+// an ISO 8601 string. It is marshalled back as a UNIX timestamp, in seconds
+// with a decimal part when below the second (eg. from millisecond values).
+//
+// Values that cannot be parsed do not fail decoding: the timestamp is left
+// zero and the value is kept in Raw, marshalled back as is.
+type Timestamp struct {
+	time.Time
+
+	// Value that could not be parsed, if any
+	Raw string `json:"-"`
+}
+
+// NewTimestamp wraps a time
+func NewTimestamp(value time.Time) *Timestamp {
+	return &Timestamp{Time: value}
+}
+
+// ToTime returns the time, or the zero time when the timestamp is nil
+func (timestamp *Timestamp) ToTime() time.Time {
+	if timestamp == nil {
+		return time.Time{}
+	}
+
+	return timestamp.Time
+}
+
+func (timestamp *Timestamp) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		timestamp.Time = time.Time{}
+		timestamp.Raw = ""
+		return nil
+	}
+
+	// Quoted date? (ISO 8601 string, or UNIX timestamp as a string)
+	if data[0] == '"' {
+		var value string
+
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+
+		timestamp.parse(value)
+
+		return nil
+	}
+
+	timestamp.parse(string(data))
+
+	return nil
+}
+
+func (timestamp Timestamp) MarshalJSON() ([]byte, error) {
+	if timestamp.IsZero() {
+		if timestamp.Raw != "" {
+			return json.Marshal(timestamp.Raw)
+		}
+
+		return []byte("null"), nil
+	}
+
+	seconds, nanoseconds := timestamp.Unix(), timestamp.Nanosecond()
+
+	if nanoseconds == 0 {
+		return []byte(strconv.FormatInt(seconds, 10)), nil
+	}
+
+	sign := ""
+
+	// Before 1970: count the decimal part towards zero too
+	if seconds < 0 {
+		sign = "-"
+		seconds, nanoseconds = -(seconds + 1), 1e9-nanoseconds
+	}
+
+	decimals := strings.TrimRight(fmt.Sprintf("%09d", nanoseconds), "0")
+
+	return []byte(fmt.Sprintf("%s%d.%s", sign, seconds, decimals)), nil
+}
+
+func (timestamp *Timestamp) parse(value string) {
+	value = strings.TrimSpace(value)
+
+	timestamp.Time = time.Time{}
+	timestamp.Raw = ""
+
+	if value == "" {
+		return
+	}
+
+	if parsed, ok := parseUnix(value); ok {
+		timestamp.Time = parsed
+
+		return
+	}
+
+	for _, layout := range timestampLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			timestamp.Time = parsed
+
+			return
+		}
+	}
+
+	// Unknown format, keep the value rather than failing the whole decoding
+	timestamp.Raw = value
+}
+
+// parseUnix reads a UNIX timestamp in seconds, with an optional decimal part,
+// or in milliseconds (eg. JavaScript timestamps)
+func parseUnix(value string) (time.Time, bool) {
+	whole, fraction, _ := strings.Cut(value, ".")
+
+	seconds, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || strings.Trim(fraction, "0123456789") != "" {
+		return parseUnixFloat(value)
+	}
+
+	nanoseconds, _ := strconv.ParseInt((fraction + "000000000")[:9], 10, 64)
+
+	if strings.HasPrefix(whole, "-") {
+		nanoseconds = -nanoseconds
+	}
+
+	if seconds >= timestampMillisecondsThreshold || seconds <= -timestampMillisecondsThreshold {
+		return time.UnixMilli(seconds).Add(time.Duration(nanoseconds / 1e3)).UTC(), true
+	}
+
+	return time.Unix(seconds, nanoseconds).UTC(), true
+}
+
+// parseUnixFloat reads other numeric notations (eg. 1.7e9)
+func parseUnixFloat(value string) (time.Time, bool) {
+	seconds, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsInf(seconds, 0) || math.IsNaN(seconds) {
+		return time.Time{}, false
+	}
+
+	if math.Abs(seconds) >= timestampMillisecondsThreshold {
+		seconds /= 1000
+	}
+
+	whole, fraction := math.Modf(seconds)
+
+	return time.Unix(int64(whole), int64(math.Round(fraction*1e9))).UTC(), true
+}
@@ -0,0 +1,98 @@
+package aircall
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimestampUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		data    string
+		want    time.Time
+		wantRaw string
+	}{
+		{`1717171200`, time.Unix(1717171200, 0), ""},
+		{`"1717171200"`, time.Unix(1717171200, 0), ""},
+		{`1717171200500`, time.Unix(1717171200, 500*int64(time.Millisecond)), ""},
+		{`"2024-05-31T16:00:00.000Z"`, time.Unix(1717171200, 0), ""},
+		{`null`, time.Time{}, ""},
+		{`"bogus"`, time.Time{}, "bogus"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.data, func(t *testing.T) {
+			var timestamp Timestamp
+
+			if err := json.Unmarshal([]byte(test.data), &timestamp); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+
+			if !timestamp.Equal(test.want) {
+				t.Errorf("Unmarshal() = %v, want %v", timestamp.Time, test.want)
+			}
+
+			if timestamp.Raw != test.wantRaw {
+				t.Errorf("Raw = %q, want %q", timestamp.Raw, test.wantRaw)
+			}
+		})
+	}
+}
+
+func TestDecodeWebhookInvalidTimestamp(t *testing.T) {
+	data := []byte(`{"resource":"contact","event":"contact.created","data":{"id":1,"created_at":"bogus"}}`)
+
+	event, err := DecodeWebhook(data)
+	if err != nil {
+		t.Fatalf("DecodeWebhook() error = %v", err)
+	}
+
+	contact := event.(*ContactEvent).Contact
+
+	if contact.ID != 1 || !contact.CreatedAt.IsZero() {
+		t.Errorf("Contact = %+v, want ID 1 and zero CreatedAt", contact)
+	}
+}
+
+func TestTimestampMarshalJSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		data string
+		want string
+	}{
+		{`1717171200`, `1717171200`},
+		{`1717171200500`, `1717171200.5`},
+		{`1717171200.123`, `1717171200.123`},
+		{`"2024-05-31T16:00:00.250Z"`, `1717171200.25`},
+		{`"weird"`, `"weird"`},
+		{`null`, `null`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.data, func(t *testing.T) {
+			var timestamp Timestamp
+
+			if err := json.Unmarshal([]byte(test.data), &timestamp); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+
+			data, err := json.Marshal(timestamp)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			if string(data) != test.want {
+				t.Errorf("Marshal() = %s, want %s", data, test.want)
+			}
+
+			var again Timestamp
+
+			if err := json.Unmarshal(data, &again); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+
+			if !again.Equal(timestamp.Time) || again.Raw != timestamp.Raw {
+				t.Errorf("round trip = %v %q, want %v %q", again.Time, again.Raw, timestamp.Time, timestamp.Raw)
+			}
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package aircall
 
 import (
+	"context"
 	"fmt"
+	"iter"
+	"time"
 )
 
 // User service
@@ -17,27 +20,45 @@ type UserResponse struct {
 }
 
 type User struct {
-	ID                 int         `json:"id,omitempty"`
-	DirectLink         string      `json:"direct_link,omitempty"`
-	Name               string      `json:"name,omitempty"`
-	Email              string      `json:"email,omitempty"`
-	CreatedAt          interface{} `json:"created_at,omitempty"`
-	Available          bool        `json:"available,omitempty"`
-	AvailabilityStatus string      `json:"availability_status,omitempty"`
-	Substatus          string      `json:"substatus,omitempty"`
-	TimeZone           string      `json:"time_zone,omitempty"`
-	Language           string      `json:"language,omitempty"`
-	WrapUpTime         int         `json:"wrap_up_time,omitempty"`
-	Numbers            *[]Number   `json:"numbers,omitempty"`
+	ID                 int                    `json:"id,omitempty"`
+	DirectLink         string                 `json:"direct_link,omitempty"`
+	Name               string                 `json:"name,omitempty"`
+	Email              string                 `json:"email,omitempty"`
+	CreatedAt          *Timestamp             `json:"created_at,omitempty"`
+	Available          bool                   `json:"available,omitempty"`
+	AvailabilityStatus UserAvailabilityStatus `json:"availability_status,omitempty"`
+	Substatus          string                 `json:"substatus,omitempty"`
+	TimeZone           string                 `json:"time_zone,omitempty"`
+	Language           string                 `json:"language,omitempty"`
+	WrapUpTime         int                    `json:"wrap_up_time,omitempty"`
+	Numbers            *[]Number              `json:"numbers,omitempty"`
+}
+
+// Availability status set by a user.
+type UserAvailabilityStatus string
+
+const (
+	UserAvailabilityStatusAvailable   UserAvailabilityStatus = "available"
+	UserAvailabilityStatusCustom      UserAvailabilityStatus = "custom"
+	UserAvailabilityStatusUnavailable UserAvailabilityStatus = "unavailable"
+)
+
+func (status UserAvailabilityStatus) IsValid() bool {
+	switch status {
+	case UserAvailabilityStatusAvailable, UserAvailabilityStatusCustom, UserAvailabilityStatusUnavailable:
+		return true
+	}
+
+	return false
 }
 
 type CreateUpdateUser struct {
-	Email              string   `json:"email,omitempty"`
-	FirstName          string   `json:"first_name,omitempty"`
-	LastName           string   `json:"last_name,omitempty"`
-	AvailabilityStatus string   `json:"availability_status,omitempty"`
-	RoleIDs            []string `json:"role_ids,omitempty"`
-	WrapUpTime         int      `json:"wrap_up_time,omitempty"`
+	Email              string                 `json:"email,omitempty"`
+	FirstName          string                 `json:"first_name,omitempty"`
+	LastName           string                 `json:"last_name,omitempty"`
+	AvailabilityStatus UserAvailabilityStatus `json:"availability_status,omitempty"`
+	RoleIDs            []string               `json:"role_ids,omitempty"`
+	WrapUpTime         int                    `json:"wrap_up_time,omitempty"`
 }
 
 type UserAvailabilitiesResponse struct {
@@ -99,9 +120,19 @@ func (p ListUsersQueryParams) To(value string) {
 	p.to(value)
 }
 
+// Set 'from' as a time for 'List' method.
+func (p ListUsersQueryParams) FromTime(value time.Time) {
+	p.fromTime(value)
+}
+
+// Set 'to' as a time for 'List' method.
+func (p ListUsersQueryParams) ToTime(value time.Time) {
+	p.toTime(value)
+}
+
 // Set 'order' for for 'List' method.
-func (p ListUsersQueryParams) Order(value string) {
-	p.order(value)
+func (p ListUsersQueryParams) Order(value SortOrder) {
+	p.order(string(value))
 }
 
 // Set 'page' and 'per_page' for for 'List' method.
@@ -111,10 +142,17 @@ func (p ListUsersQueryParams) Paginate(page int, perPage int) {
 
 // List users. Reference: https://developer.aircall.io/api-references/#list-all-users
 func (service *UsersService) List(opts *ListUsersQueryParams) (*UsersResponse, *Response, error) {
+	return service.ListContext(context.Background(), opts)
+}
+
+// List users using the given context. Reference: https://developer.aircall.io/api-references/#list-all-users
+func (service *UsersService) ListContext(ctx context.Context, opts *ListUsersQueryParams) (*UsersResponse, *Response, error) {
+	ctx = withOperation(ctx, "User.List")
+
 	_url := "users"
 
 	responseBody := new(UsersResponse)
-	response, err := service.client.Get(_url, opts, responseBody)
+	response, err := service.client.GetContext(ctx, _url, opts, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -123,6 +161,41 @@ func (service *UsersService) List(opts *ListUsersQueryParams) (*UsersResponse, *
 	return responseBody, response, nil
 }
 
+// Iterate over all users, following pagination. An optional cap on the number
+// of items can be given. Reference: https://developer.aircall.io/api-references/#list-all-users
+func (service *UsersService) All(ctx context.Context, opts *ListUsersQueryParams, maxItems ...int) iter.Seq2[User, error] {
+	ctx = withOperation(ctx, "User.All")
+
+	_url := "users"
+
+	return paginate(ctx, service.client, _url, opts, maxItems, func(page *UsersResponse) (*GenericResponseMeta, *[]User) {
+		return page.Meta, page.Users
+	})
+}
+
+// Iterate over all users created between 'from' and 'to'. The range is split in
+// smaller windows when it holds more items than Aircall paginates through
+// (10,000). Reference: https://developer.aircall.io/api-references/#list-all-users
+func (service *UsersService) AllBetween(ctx context.Context, opts *ListUsersQueryParams, from time.Time, to time.Time) iter.Seq2[User, error] {
+	ctx = withOperation(ctx, "User.AllBetween")
+
+	_url := "users"
+
+	var values QueryValues
+
+	if opts != nil {
+		values = opts.QueryValues
+	}
+
+	page := func(page *UsersResponse) (*GenericResponseMeta, *[]User) {
+		return page.Meta, page.Users
+	}
+
+	return paginateWindows(ctx, service.client, _url, values, from, to, page, func(item User) int {
+		return item.ID
+	})
+}
+
 //  ***********************************************************************************
 //  GET USER
 //  https://developer.aircall.io/api-references/#retrieve-a-user
@@ -130,10 +203,17 @@ func (service *UsersService) List(opts *ListUsersQueryParams) (*UsersResponse, *
 
 // Get a user. Reference: https://developer.aircall.io/api-references/#retrieve-a-user
 func (service *UsersService) Get(userID int) (*UserResponse, *Response, error) {
+	return service.GetContext(context.Background(), userID)
+}
+
+// Get a user using the given context. Reference: https://developer.aircall.io/api-references/#retrieve-a-user
+func (service *UsersService) GetContext(ctx context.Context, userID int) (*UserResponse, *Response, error) {
+	ctx = withOperation(ctx, "User.Get")
+
 	_url := fmt.Sprintf("users/%d", userID)
 
 	responseBody := new(UserResponse)
-	response, err := service.client.Get(_url, nil, responseBody)
+	response, err := service.client.GetContext(ctx, _url, nil, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -149,10 +229,17 @@ func (service *UsersService) Get(userID int) (*UserResponse, *Response, error) {
 
 // Create a user. Reference: https://developer.aircall.io/api-references/#create-a-user
 func (service *UsersService) Create(user *CreateUpdateUser) (*UserResponse, *Response, error) {
+	return service.CreateContext(context.Background(), user)
+}
+
+// Create a user using the given context. Reference: https://developer.aircall.io/api-references/#create-a-user
+func (service *UsersService) CreateContext(ctx context.Context, user *CreateUpdateUser) (*UserResponse, *Response, error) {
+	ctx = withOperation(ctx, "User.Create")
+
 	_url := "users"
 
 	responseBody := new(UserResponse)
-	response, err := service.client.Post(_url, user, responseBody)
+	response, err := service.client.PostContext(ctx, _url, user, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -168,10 +255,17 @@ func (service *UsersService) Create(user *CreateUpdateUser) (*UserResponse, *Res
 
 // Update a user. Reference: https://developer.aircall.io/api-references/#update-a-user
 func (service *UsersService) Update(userID int, user *CreateUpdateUser) (*UserResponse, *Response, error) {
+	return service.UpdateContext(context.Background(), userID, user)
+}
+
+// Update a user using the given context. Reference: https://developer.aircall.io/api-references/#update-a-user
+func (service *UsersService) UpdateContext(ctx context.Context, userID int, user *CreateUpdateUser) (*UserResponse, *Response, error) {
+	ctx = withOperation(ctx, "User.Update")
+
 	_url := fmt.Sprintf("users/%d", userID)
 
 	responseBody := new(UserResponse)
-	response, err := service.client.Put(_url, user, responseBody)
+	response, err := service.client.PutContext(ctx, _url, user, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -187,9 +281,16 @@ func (service *UsersService) Update(userID int, user *CreateUpdateUser) (*UserRe
 
 // Delete a user. Reference: https://developer.aircall.io/api-references/#delete-a-user
 func (service *UsersService) Delete(userID int) (*Response, error) {
+	return service.DeleteContext(context.Background(), userID)
+}
+
+// Delete a user using the given context. Reference: https://developer.aircall.io/api-references/#delete-a-user
+func (service *UsersService) DeleteContext(ctx context.Context, userID int) (*Response, error) {
+	ctx = withOperation(ctx, "User.Delete")
+
 	_url := fmt.Sprintf("users/%d", userID)
 
-	return service.client.Delete(_url)
+	return service.client.DeleteContext(ctx, _url)
 }
 
 //  ***********************************************************************************
@@ -214,9 +315,19 @@ func (p ListUsersAvailabilityQueryParams) To(value string) {
 	p.to(value)
 }
 
+// Set 'from' as a time for 'ListAvailability' method.
+func (p ListUsersAvailabilityQueryParams) FromTime(value time.Time) {
+	p.fromTime(value)
+}
+
+// Set 'to' as a time for 'ListAvailability' method.
+func (p ListUsersAvailabilityQueryParams) ToTime(value time.Time) {
+	p.toTime(value)
+}
+
 // Set 'order' for for 'ListAvailability' method.
-func (p ListUsersAvailabilityQueryParams) Order(value string) {
-	p.order(value)
+func (p ListUsersAvailabilityQueryParams) Order(value SortOrder) {
+	p.order(string(value))
 }
 
 // Set 'page' and 'per_page' for for 'ListAvailability' method.
@@ -226,10 +337,17 @@ func (p ListUsersAvailabilityQueryParams) Paginate(page int, perPage int) {
 
 // List users availabilies. Reference: https://developer.aircall.io/api-references/#retrieve-list-of-users-availability
 func (service *UsersService) ListAvailabilities(opts *ListUsersAvailabilityQueryParams) (*UserAvailabilitiesResponse, *Response, error) {
+	return service.ListAvailabilitiesContext(context.Background(), opts)
+}
+
+// List users availabilies using the given context. Reference: https://developer.aircall.io/api-references/#retrieve-list-of-users-availability
+func (service *UsersService) ListAvailabilitiesContext(ctx context.Context, opts *ListUsersAvailabilityQueryParams) (*UserAvailabilitiesResponse, *Response, error) {
+	ctx = withOperation(ctx, "User.ListAvailabilities")
+
 	_url := "users/availabilities"
 
 	responseBody := new(UserAvailabilitiesResponse)
-	response, err := service.client.Get(_url, opts, responseBody)
+	response, err := service.client.GetContext(ctx, _url, opts, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -238,6 +356,18 @@ func (service *UsersService) ListAvailabilities(opts *ListUsersAvailabilityQuery
 	return responseBody, response, nil
 }
 
+// Iterate over all users availabilities, following pagination. An optional cap on the number
+// of items can be given. Reference: https://developer.aircall.io/api-references/#retrieve-list-of-users-availability
+func (service *UsersService) AllAvailabilities(ctx context.Context, opts *ListUsersAvailabilityQueryParams, maxItems ...int) iter.Seq2[UserAvailability, error] {
+	ctx = withOperation(ctx, "User.AllAvailabilities")
+
+	_url := "users/availabilities"
+
+	return paginate(ctx, service.client, _url, opts, maxItems, func(page *UserAvailabilitiesResponse) (*GenericResponseMeta, *[]UserAvailability) {
+		return page.Meta, page.Users
+	})
+}
+
 //  ***********************************************************************************
 //  GET USER AVAILABILITY
 //  https://developer.aircall.io/api-references/#check-availability-of-a-user
@@ -245,10 +375,17 @@ func (service *UsersService) ListAvailabilities(opts *ListUsersAvailabilityQuery
 
 // Get a user availability. Reference: https://developer.aircall.io/api-references/#check-availability-of-a-user
 func (service *UsersService) GetAvailability(userID int) (*UserAvailability, *Response, error) {
+	return service.GetAvailabilityContext(context.Background(), userID)
+}
+
+// Get a user availability using the given context. Reference: https://developer.aircall.io/api-references/#check-availability-of-a-user
+func (service *UsersService) GetAvailabilityContext(ctx context.Context, userID int) (*UserAvailability, *Response, error) {
+	ctx = withOperation(ctx, "User.GetAvailability")
+
 	_url := fmt.Sprintf("users/%d/availability", userID)
 
 	responseBody := new(UserAvailability)
-	response, err := service.client.Get(_url, nil, responseBody)
+	response, err := service.client.GetContext(ctx, _url, nil, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -264,9 +401,16 @@ func (service *UsersService) GetAvailability(userID int) (*UserAvailability, *Re
 
 // Start an outbound call. Reference: https://developer.aircall.io/api-references/#start-an-outbound-call
 func (service *UsersService) StartOutboundCall(userID int, call *NewUserCall) (*Response, error) {
+	return service.StartOutboundCallContext(context.Background(), userID, call)
+}
+
+// Start an outbound call using the given context. Reference: https://developer.aircall.io/api-references/#start-an-outbound-call
+func (service *UsersService) StartOutboundCallContext(ctx context.Context, userID int, call *NewUserCall) (*Response, error) {
+	ctx = withOperation(ctx, "User.StartOutboundCall")
+
 	_url := fmt.Sprintf("users/%d/calls", userID)
 
-	return service.client.Post(_url, call, nil)
+	return service.client.PostContext(ctx, _url, call, nil)
 }
 
 //  ***********************************************************************************
@@ -276,7 +420,14 @@ func (service *UsersService) StartOutboundCall(userID int, call *NewUserCall) (*
 
 // Dial a phone number in the phone. Reference: https://developer.aircall.io/api-references/#dial-a-phone-number-in-the-phone
 func (service *UsersService) DialNumber(userID int, call *NewUserCall) (*Response, error) {
+	return service.DialNumberContext(context.Background(), userID, call)
+}
+
+// Dial a phone number in the phone using the given context. Reference: https://developer.aircall.io/api-references/#dial-a-phone-number-in-the-phone
+func (service *UsersService) DialNumberContext(ctx context.Context, userID int, call *NewUserCall) (*Response, error) {
+	ctx = withOperation(ctx, "User.DialNumber")
+
 	_url := fmt.Sprintf("users/%d/dail", userID)
 
-	return service.client.Post(_url, call, nil)
+	return service.client.PostContext(ctx, _url, call, nil)
 }
@@ -0,0 +1,184 @@
+package aircall
+
+import "fmt"
+
+// Name of an Aircall webhook event (eg. "call.created").
+type WebhookEvent string
+
+// Webhook resources
+const (
+	WebhookResourceCall                     = "call"
+	WebhookResourceUser                     = "user"
+	WebhookResourceContact                  = "contact"
+	WebhookResourceNumber                   = "number"
+	WebhookResourceMessage                  = "message"
+	WebhookResourceIntegration              = "integration"
+	WebhookResourceConversationIntelligence = "conversation_intelligence"
+)
+
+// Call events. Reference: https://developer.aircall.io/api-references/#call-events
+const (
+	WebhookEventCallCreated              WebhookEvent = "call.created"
+	WebhookEventCallRingingOnAgent       WebhookEvent = "call.ringing_on_agent"
+	WebhookEventCallAgentDeclined        WebhookEvent = "call.agent_declined"
+	WebhookEventCallAnswered             WebhookEvent = "call.answered"
+	WebhookEventCallTransferred          WebhookEvent = "call.transferred"
+	WebhookEventCallUnsuccessfulTransfer WebhookEvent = "call.unsuccessful_transfer"
+	WebhookEventCallHungup               WebhookEvent = "call.hungup"
+	WebhookEventCallEnded                WebhookEvent = "call.ended"
+	WebhookEventCallVoicemailLeft        WebhookEvent = "call.voicemail_left"
+	WebhookEventCallAssigned             WebhookEvent = "call.assigned"
+	WebhookEventCallArchived             WebhookEvent = "call.archived"
+	WebhookEventCallTagged               WebhookEvent = "call.tagged"
+	WebhookEventCallUntagged             WebhookEvent = "call.untagged"
+	WebhookEventCallCommented            WebhookEvent = "call.commented"
+	WebhookEventCallCommAssetsGenerated  WebhookEvent = "call.comm_assets_generated"
+)
+
+// User events. Reference: https://developer.aircall.io/api-references/#user-events
+const (
+	WebhookEventUserCreated      WebhookEvent = "user.created"
+	WebhookEventUserDeleted      WebhookEvent = "user.deleted"
+	WebhookEventUserConnected    WebhookEvent = "user.connected"
+	WebhookEventUserDisconnected WebhookEvent = "user.disconnected"
+	WebhookEventUserOpened       WebhookEvent = "user.opened"
+	WebhookEventUserClosed       WebhookEvent = "user.closed"
+	WebhookEventUserWutStart     WebhookEvent = "user.wut_start"
+	WebhookEventUserWutEnd       WebhookEvent = "user.wut_end"
+)
+
+// Contact events. Reference: https://developer.aircall.io/api-references/#contact-events
+const (
+	WebhookEventContactCreated WebhookEvent = "contact.created"
+	WebhookEventContactUpdated WebhookEvent = "contact.updated"
+	WebhookEventContactDeleted WebhookEvent = "contact.deleted"
+)
+
+// Number events. Reference: https://developer.aircall.io/api-references/#number-events
+const (
+	WebhookEventNumberCreated WebhookEvent = "number.created"
+	WebhookEventNumberDeleted WebhookEvent = "number.deleted"
+	WebhookEventNumberOpened  WebhookEvent = "number.opened"
+	WebhookEventNumberClosed  WebhookEvent = "number.closed"
+)
+
+// Message events. Reference: https://developer.aircall.io/api-references/#message-events
+const (
+	WebhookEventMessageSent          WebhookEvent = "message.sent"
+	WebhookEventMessageReceived      WebhookEvent = "message.received"
+	WebhookEventMessageStatusUpdated WebhookEvent = "message.status_updated"
+)
+
+// Integration events. Reference: https://developer.aircall.io/api-references/#integration-events
+const (
+	WebhookEventIntegrationCreated WebhookEvent = "integration.created"
+	WebhookEventIntegrationDeleted WebhookEvent = "integration.deleted"
+)
+
+// Conversation intelligence events. Reference: https://developer.aircall.io/api-references/#conversation-intelligence-events
+const (
+	WebhookEventTranscriptionCreated WebhookEvent = "transcription.created"
+	WebhookEventSentimentCreated     WebhookEvent = "sentiment.created"
+	WebhookEventTopicCreated         WebhookEvent = "topic.created"
+	WebhookEventSummaryCreated       WebhookEvent = "summary.created"
+	WebhookEventActionItemCreated    WebhookEvent = "action_item.created"
+)
+
+// Events of each webhook resource
+var webhookEventsByResource = map[string][]WebhookEvent{
+	WebhookResourceCall: {
+		WebhookEventCallCreated, WebhookEventCallRingingOnAgent, WebhookEventCallAgentDeclined,
+		WebhookEventCallAnswered, WebhookEventCallTransferred, WebhookEventCallUnsuccessfulTransfer,
+		WebhookEventCallHungup, WebhookEventCallEnded, WebhookEventCallVoicemailLeft,
+		WebhookEventCallAssigned, WebhookEventCallArchived, WebhookEventCallTagged,
+		WebhookEventCallUntagged, WebhookEventCallCommented, WebhookEventCallCommAssetsGenerated,
+	},
+	WebhookResourceUser: {
+		WebhookEventUserCreated, WebhookEventUserDeleted, WebhookEventUserConnected,
+		WebhookEventUserDisconnected, WebhookEventUserOpened, WebhookEventUserClosed,
+		WebhookEventUserWutStart, WebhookEventUserWutEnd,
+	},
+	WebhookResourceContact: {
+		WebhookEventContactCreated, WebhookEventContactUpdated, WebhookEventContactDeleted,
+	},
+	WebhookResourceNumber: {
+		WebhookEventNumberCreated, WebhookEventNumberDeleted, WebhookEventNumberOpened, WebhookEventNumberClosed,
+	},
+	WebhookResourceMessage: {
+		WebhookEventMessageSent, WebhookEventMessageReceived, WebhookEventMessageStatusUpdated,
+	},
+	WebhookResourceIntegration: {
+		WebhookEventIntegrationCreated, WebhookEventIntegrationDeleted,
+	},
+	WebhookResourceConversationIntelligence: {
+		WebhookEventTranscriptionCreated, WebhookEventSentimentCreated, WebhookEventTopicCreated,
+		WebhookEventSummaryCreated, WebhookEventActionItemCreated,
+	},
+}
+
+// Resource of each webhook event
+var webhookEventResources = func() map[WebhookEvent]string {
+	resources := map[WebhookEvent]string{}
+
+	for resource, events := range webhookEventsByResource {
+		for _, event := range events {
+			resources[event] = resource
+		}
+	}
+
+	return resources
+}()
+
+// IsValid reports whether the event is a known Aircall event
+func (event WebhookEvent) IsValid() bool {
+	_, ok := webhookEventResources[event]
+
+	return ok
+}
+
+// Resource returns the resource the event belongs to (eg. "call"), or an
+// empty string for unknown events
+func (event WebhookEvent) Resource() string {
+	return webhookEventResources[event]
+}
+
+// WebhookEventsForResource returns the events of a resource (eg. "call")
+func WebhookEventsForResource(resource string) []WebhookEvent {
+	return append([]WebhookEvent(nil), webhookEventsByResource[resource]...)
+}
+
+// WebhookEventError is returned before creating or updating a webhook
+// subscribed to unknown events. It matches ErrValidation.
+type WebhookEventError struct {
+	Events []WebhookEvent
+}
+
+func (e *WebhookEventError) Error() string {
+	return fmt.Sprintf("unknown webhook events: %v", e.Events)
+}
+
+func (e *WebhookEventError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// ValidateEvents checks the webhook only subscribes to known events, unless
+// SkipEventValidation is set
+func (webhook *CreateUpdateWebhook) ValidateEvents() error {
+	var unknown []WebhookEvent
+
+	if webhook == nil || webhook.SkipEventValidation {
+		return nil
+	}
+
+	for _, event := range webhook.Events {
+		if !event.IsValid() {
+			unknown = append(unknown, event)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return &WebhookEventError{Events: unknown}
+	}
+
+	return nil
+}
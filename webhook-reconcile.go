@@ -0,0 +1,315 @@
+package aircall
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Action planned on a webhook by Reconcile.
+type WebhookPlanAction string
+
+const (
+	WebhookPlanCreate    WebhookPlanAction = "create"
+	WebhookPlanUpdate    WebhookPlanAction = "update"
+	WebhookPlanDelete    WebhookPlanAction = "delete"
+	WebhookPlanUnchanged WebhookPlanAction = "unchanged"
+)
+
+// WebhookChange is a change planned on a single webhook.
+type WebhookChange struct {
+	Action WebhookPlanAction
+
+	// Desired webhook. Nil for deletions.
+	Desired *CreateUpdateWebhook
+
+	// Existing webhook. Nil for creations.
+	Current *Webhook
+
+	// Event changes of an update
+	AddedEvents   []WebhookEvent
+	RemovedEvents []WebhookEvent
+
+	// Set when the update changes the webhook URL or name
+	URLChanged  bool
+	NameChanged bool
+
+	// Set when the existing webhook is inactive and will be reactivated
+	Reactivate bool
+}
+
+// Key identifying the webhook: its custom name, or URL when unnamed.
+func (change *WebhookChange) Key() string {
+	if change.Desired != nil {
+		return webhookKey(change.Desired.CustomName, change.Desired.URL)
+	}
+
+	return webhookKey(change.Current.CustomName, change.Current.URL)
+}
+
+// WebhookPlan lists the changes bringing webhooks to the desired state.
+type WebhookPlan struct {
+	Changes []WebhookChange
+}
+
+// HasChanges reports whether applying the plan would change anything
+func (plan *WebhookPlan) HasChanges() bool {
+	return slices.ContainsFunc(plan.Changes, func(change WebhookChange) bool {
+		return change.Action != WebhookPlanUnchanged
+	})
+}
+
+// String renders the plan for dry-runs, one change per line.
+func (plan *WebhookPlan) String() string {
+	builder := strings.Builder{}
+
+	for _, change := range plan.Changes {
+		switch change.Action {
+		case WebhookPlanCreate:
+			fmt.Fprintf(&builder, "+ create %q %s %v\n", change.Key(), change.Desired.URL, change.Desired.Events)
+		case WebhookPlanUpdate:
+			fmt.Fprintf(&builder, "~ update %q (%s)", change.Key(), change.Current.WebhookID)
+
+			if change.NameChanged {
+				fmt.Fprintf(&builder, " name %q -> %q", change.Current.CustomName, change.Desired.CustomName)
+			}
+
+			if change.URLChanged {
+				fmt.Fprintf(&builder, " url %s -> %s", change.Current.URL, change.Desired.URL)
+			}
+
+			for _, event := range change.AddedEvents {
+				fmt.Fprintf(&builder, " +%s", event)
+			}
+
+			for _, event := range change.RemovedEvents {
+				fmt.Fprintf(&builder, " -%s", event)
+			}
+
+			if change.Reactivate {
+				builder.WriteString(" reactivate")
+			}
+
+			builder.WriteString("\n")
+		case WebhookPlanDelete:
+			fmt.Fprintf(&builder, "- delete %q (%s) %s\n", change.Key(), change.Current.WebhookID, change.Current.URL)
+		case WebhookPlanUnchanged:
+			fmt.Fprintf(&builder, "  unchanged %q (%s)\n", change.Key(), change.Current.WebhookID)
+		}
+	}
+
+	return builder.String()
+}
+
+// WebhookReconcileOptions scopes the deletion of existing webhooks matching no
+// desired webhook. By default they are all deleted.
+type WebhookReconcileOptions struct {
+	// Keep unmatched webhooks instead of deleting them
+	KeepUnmatched bool
+
+	// Only delete unmatched webhooks whose custom name starts with the prefix
+	// (eg. the webhooks owned by this service)
+	PrunePrefix string
+}
+
+// prunes reports whether an unmatched webhook is deleted
+func (options *WebhookReconcileOptions) prunes(webhook *Webhook) bool {
+	if options.KeepUnmatched {
+		return false
+	}
+
+	return strings.HasPrefix(webhook.CustomName, options.PrunePrefix)
+}
+
+// WebhookReconcileResult holds the outcome of an applied plan.
+type WebhookReconcileResult struct {
+	Plan *WebhookPlan
+
+	// Changes applied before an error, if any
+	Applied []WebhookChange
+
+	// Tokens of the created webhooks, by custom name (or URL when unnamed)
+	Tokens map[string]string
+}
+
+func webhookKey(customName string, url string) string {
+	if customName != "" {
+		return customName
+	}
+
+	return url
+}
+
+// matches reports whether an existing webhook is the desired one: same custom
+// name when one is desired, otherwise same URL.
+func (webhook *CreateUpdateWebhook) matches(existing *Webhook) bool {
+	if webhook.CustomName != "" {
+		return webhook.CustomName == existing.CustomName
+	}
+
+	return webhook.URL == existing.URL
+}
+
+//  ***********************************************************************************
+//  RECONCILE WEBHOOKS
+//  ***********************************************************************************
+
+// Plan the changes bringing the account webhooks to the desired ones, without
+// applying them. Desired webhooks are matched by custom name, or by URL when
+// unnamed. Existing webhooks matching none of them are planned for deletion,
+// as scoped by the options.
+func (service *WebhookService) Plan(ctx context.Context, desired []CreateUpdateWebhook, options ...WebhookReconcileOptions) (*WebhookPlan, error) {
+	keys := map[string]struct{}{}
+	scope := WebhookReconcileOptions{}
+
+	if len(options) > 0 {
+		scope = options[0]
+	}
+
+	for i := range desired {
+		if err := desired[i].ValidateEvents(); err != nil {
+			return nil, err
+		}
+
+		key := webhookKey(desired[i].CustomName, desired[i].URL)
+
+		if desired[i].URL == "" {
+			return nil, fmt.Errorf("%w: webhook %q has no URL", ErrValidation, key)
+		}
+
+		// Events are omitted from updates when empty, the plan would never converge
+		if len(desired[i].Events) == 0 {
+			return nil, fmt.Errorf("%w: webhook %q has no events", ErrValidation, key)
+		}
+
+		if _, ok := keys[key]; ok {
+			return nil, fmt.Errorf("%w: webhook %q is desired more than once", ErrValidation, key)
+		}
+
+		keys[key] = struct{}{}
+	}
+
+	existing := []Webhook{}
+
+	for webhook, err := range service.All(ctx, nil) {
+		if err != nil {
+			return nil, err
+		}
+
+		existing = append(existing, webhook)
+	}
+
+	plan := &WebhookPlan{}
+	matched := make([]bool, len(existing))
+
+	for i := range desired {
+		webhook := &desired[i]
+
+		// First existing webhook not matched yet, extra ones are stale duplicates
+		index := -1
+
+		for j := range existing {
+			if !matched[j] && webhook.matches(&existing[j]) {
+				index = j
+				break
+			}
+		}
+
+		if index < 0 {
+			plan.Changes = append(plan.Changes, WebhookChange{Action: WebhookPlanCreate, Desired: webhook})
+			continue
+		}
+
+		matched[index] = true
+		plan.Changes = append(plan.Changes, diffWebhook(webhook, &existing[index]))
+	}
+
+	for i := range existing {
+		if !matched[i] && scope.prunes(&existing[i]) {
+			plan.Changes = append(plan.Changes, WebhookChange{Action: WebhookPlanDelete, Current: &existing[i]})
+		}
+	}
+
+	return plan, nil
+}
+
+// diffWebhook plans the update of an existing webhook
+func diffWebhook(desired *CreateUpdateWebhook, current *Webhook) WebhookChange {
+	change := WebhookChange{Action: WebhookPlanUnchanged, Desired: desired, Current: current}
+
+	for _, event := range desired.Events {
+		if !slices.Contains(current.Events, event) {
+			change.AddedEvents = append(change.AddedEvents, event)
+		}
+	}
+
+	for _, event := range current.Events {
+		if !slices.Contains(desired.Events, event) {
+			change.RemovedEvents = append(change.RemovedEvents, event)
+		}
+	}
+
+	change.URLChanged = desired.URL != "" && desired.URL != current.URL
+	change.NameChanged = desired.CustomName != "" && desired.CustomName != current.CustomName
+	change.Reactivate = !current.Active
+
+	if len(change.AddedEvents) > 0 || len(change.RemovedEvents) > 0 || change.URLChanged || change.NameChanged || change.Reactivate {
+		change.Action = WebhookPlanUpdate
+	}
+
+	return change
+}
+
+// Apply a plan returned by Plan. Stops at the first failing change, returning
+// the changes applied so far.
+func (service *WebhookService) Apply(ctx context.Context, plan *WebhookPlan) (*WebhookReconcileResult, error) {
+	result := &WebhookReconcileResult{Plan: plan, Tokens: map[string]string{}}
+
+	for _, change := range plan.Changes {
+		switch change.Action {
+		case WebhookPlanCreate:
+			response, _, err := service.CreateContext(ctx, change.Desired)
+			if err != nil {
+				return result, fmt.Errorf("create webhook %q: %w", change.Key(), err)
+			}
+
+			if response.Webhook != nil {
+				result.Tokens[change.Key()] = response.Webhook.Token
+			}
+		case WebhookPlanUpdate:
+			update := *change.Desired
+
+			if change.Reactivate {
+				active := true
+				update.Active = &active
+			}
+
+			if _, _, err := service.UpdateContext(ctx, change.Current.WebhookID, &update); err != nil {
+				return result, fmt.Errorf("update webhook %q: %w", change.Key(), err)
+			}
+		case WebhookPlanDelete:
+			if _, err := service.DeleteContext(ctx, change.Current.WebhookID); err != nil {
+				return result, fmt.Errorf("delete webhook %q: %w", change.Key(), err)
+			}
+		default:
+			continue
+		}
+
+		result.Applied = append(result.Applied, change)
+	}
+
+	return result, nil
+}
+
+// Reconcile the account webhooks with the desired ones: create missing
+// webhooks, update events, reactivate inactive webhooks and delete stale ones
+// (as scoped by the options). Use Plan for a dry-run.
+func (service *WebhookService) Reconcile(ctx context.Context, desired []CreateUpdateWebhook, options ...WebhookReconcileOptions) (*WebhookReconcileResult, error) {
+	plan, err := service.Plan(ctx, desired, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.Apply(ctx, plan)
+}
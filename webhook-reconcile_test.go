@@ -0,0 +1,203 @@
+package aircall_test
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/dinistavares/go-aircall-api"
+	"github.com/dinistavares/go-aircall-api/aircalltest"
+)
+
+// seedReconcileWebhooks seeds an up to date webhook, one missing an event,
+// an inactive one and one owned by another tool
+func seedReconcileWebhooks(server *aircalltest.Server) {
+	server.SeedWebhook(aircall.Webhook{
+		CustomName: "billing-calls",
+		URL:        "https://example.com/calls",
+		Active:     true,
+		Events:     []aircall.WebhookEvent{aircall.WebhookEventCallCreated, aircall.WebhookEventCallEnded},
+	})
+
+	server.SeedWebhook(aircall.Webhook{
+		CustomName: "billing-contacts",
+		URL:        "https://example.com/contacts",
+		Active:     true,
+		Events:     []aircall.WebhookEvent{aircall.WebhookEventContactCreated},
+	})
+
+	server.SeedWebhook(aircall.Webhook{
+		CustomName: "billing-users",
+		URL:        "https://example.com/users",
+		Events:     []aircall.WebhookEvent{aircall.WebhookEventUserCreated},
+	})
+
+	server.SeedWebhook(aircall.Webhook{
+		CustomName: "crm-sync",
+		URL:        "https://crm.example.com/aircall",
+		Active:     true,
+		Events:     []aircall.WebhookEvent{aircall.WebhookEventCallEnded},
+	})
+}
+
+func reconcileDesired() []aircall.CreateUpdateWebhook {
+	return []aircall.CreateUpdateWebhook{
+		{
+			CustomName: "billing-calls",
+			URL:        "https://example.com/calls",
+			Events:     []aircall.WebhookEvent{aircall.WebhookEventCallCreated, aircall.WebhookEventCallEnded},
+		},
+		{
+			CustomName: "billing-contacts",
+			URL:        "https://example.com/contacts",
+			Events:     []aircall.WebhookEvent{aircall.WebhookEventContactCreated, aircall.WebhookEventContactDeleted},
+		},
+		{
+			CustomName: "billing-users",
+			URL:        "https://example.com/users",
+			Events:     []aircall.WebhookEvent{aircall.WebhookEventUserCreated},
+		},
+		{
+			CustomName: "billing-messages",
+			URL:        "https://example.com/messages",
+			Events:     []aircall.WebhookEvent{aircall.WebhookEventMessageReceived},
+		},
+	}
+}
+
+func planActions(plan *aircall.WebhookPlan) map[string]aircall.WebhookPlanAction {
+	actions := map[string]aircall.WebhookPlanAction{}
+
+	for _, change := range plan.Changes {
+		actions[change.Key()] = change.Action
+	}
+
+	return actions
+}
+
+func TestWebhookPlan(t *testing.T) {
+	server := aircalltest.NewServer()
+	defer server.Close()
+
+	seedReconcileWebhooks(server)
+
+	plan, err := server.Client().Webhook.Plan(context.Background(), reconcileDesired())
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+
+	want := map[string]aircall.WebhookPlanAction{
+		"billing-calls":    aircall.WebhookPlanUnchanged,
+		"billing-contacts": aircall.WebhookPlanUpdate,
+		"billing-users":    aircall.WebhookPlanUpdate,
+		"billing-messages": aircall.WebhookPlanCreate,
+		"crm-sync":         aircall.WebhookPlanDelete,
+	}
+
+	if got := planActions(plan); !maps.Equal(got, want) {
+		t.Errorf("Plan() actions = %v, want %v", got, want)
+	}
+
+	for _, change := range plan.Changes {
+		switch change.Key() {
+		case "billing-contacts":
+			if !slices.Equal(change.AddedEvents, []aircall.WebhookEvent{aircall.WebhookEventContactDeleted}) || len(change.RemovedEvents) > 0 {
+				t.Errorf("billing-contacts events = +%v -%v, want +[contact.deleted]", change.AddedEvents, change.RemovedEvents)
+			}
+		case "billing-users":
+			if !change.Reactivate || len(change.AddedEvents) > 0 || len(change.RemovedEvents) > 0 {
+				t.Errorf("billing-users change = %+v, want a reactivation only", change)
+			}
+		}
+	}
+}
+
+func TestWebhookPlanScopesPruning(t *testing.T) {
+	tests := []struct {
+		name    string
+		options aircall.WebhookReconcileOptions
+		want    bool
+	}{
+		{"default", aircall.WebhookReconcileOptions{}, true},
+		{"keep unmatched", aircall.WebhookReconcileOptions{KeepUnmatched: true}, false},
+		{"other prefix", aircall.WebhookReconcileOptions{PrunePrefix: "billing-"}, false},
+		{"matching prefix", aircall.WebhookReconcileOptions{PrunePrefix: "crm-"}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := aircalltest.NewServer()
+			defer server.Close()
+
+			seedReconcileWebhooks(server)
+
+			plan, err := server.Client().Webhook.Plan(context.Background(), reconcileDesired(), test.options)
+			if err != nil {
+				t.Fatalf("Plan() error = %v", err)
+			}
+
+			action, ok := planActions(plan)["crm-sync"]
+
+			if deleted := ok && action == aircall.WebhookPlanDelete; deleted != test.want {
+				t.Errorf("crm-sync deleted = %v, want %v", deleted, test.want)
+			}
+		})
+	}
+}
+
+func TestWebhookReconcileConverges(t *testing.T) {
+	server := aircalltest.NewServer()
+	defer server.Close()
+
+	seedReconcileWebhooks(server)
+
+	client := server.Client()
+
+	result, err := client.Webhook.Reconcile(context.Background(), reconcileDesired())
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	if result.Tokens["billing-messages"] == "" {
+		t.Errorf("Reconcile() tokens = %v, want the token of billing-messages", result.Tokens)
+	}
+
+	plan, err := client.Webhook.Plan(context.Background(), reconcileDesired())
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+
+	if plan.HasChanges() {
+		t.Errorf("Plan() after Reconcile() has changes:\n%s", plan)
+	}
+}
+
+func TestWebhookPlanValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		desired aircall.CreateUpdateWebhook
+	}{
+		{"no events", aircall.CreateUpdateWebhook{CustomName: "calls", URL: "https://example.com/calls"}},
+		{"no URL", aircall.CreateUpdateWebhook{CustomName: "calls", Events: []aircall.WebhookEvent{aircall.WebhookEventCallEnded}}},
+		{"unknown event", aircall.CreateUpdateWebhook{URL: "https://example.com/calls", Events: []aircall.WebhookEvent{"call.teleported"}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := aircalltest.NewServer()
+			defer server.Close()
+
+			_, err := server.Client().Webhook.Plan(context.Background(), []aircall.CreateUpdateWebhook{test.desired})
+
+			if !errors.Is(err, aircall.ErrValidation) {
+				t.Errorf("Plan() error = %v, want ErrValidation", err)
+			}
+
+			if server.Requests() != 0 {
+				t.Errorf("Plan() sent %d requests, want none", server.Requests())
+			}
+		})
+	}
+}
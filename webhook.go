@@ -1,7 +1,9 @@
 package aircall
 
 import (
+	"context"
 	"fmt"
+	"iter"
 )
 
 // Webhook service
@@ -17,19 +19,25 @@ type WebhookResponse struct {
 }
 
 type CreateUpdateWebhook struct {
-	CustomName string   `json:"custom_name,omitempty"`
-	URL        string   `json:"url,omitempty"`
-	Events     []string `json:"events,omitempty"`
+	CustomName string         `json:"custom_name,omitempty"`
+	URL        string         `json:"url,omitempty"`
+	Events     []WebhookEvent `json:"events,omitempty"`
+	Active     *bool          `json:"active,omitempty"`
+
+	// Send events unknown to this library (eg. released after it) instead of
+	// rejecting them
+	SkipEventValidation bool `json:"-"`
 }
 
 type Webhook struct {
-	WebhookID  string      `json:"webhook_id,omitempty"`
-	DirectLink string      `json:"direct_link,omitempty"`
-	CreatedAt  interface{} `json:"created_at,omitempty"`
-	URL        string      `json:"url,omitempty"`
-	Active     bool        `json:"active,omitempty"`
-	Token      string      `json:"token,omitempty"`
-	Events     *[]string   `json:"events,omitempty"`
+	WebhookID  string         `json:"webhook_id,omitempty"`
+	CustomName string         `json:"custom_name,omitempty"`
+	DirectLink string         `json:"direct_link,omitempty"`
+	CreatedAt  *Timestamp     `json:"created_at,omitempty"`
+	URL        string         `json:"url,omitempty"`
+	Active     bool           `json:"active,omitempty"`
+	Token      string         `json:"token,omitempty"`
+	Events     []WebhookEvent `json:"events,omitempty"`
 }
 
 type WebhookQueries struct{}
@@ -56,8 +64,8 @@ func (pq WebhookQueries) NewListWebhooks() *ListWebhooksQueryParams {
 	}
 }
 
-func (p ListWebhooksQueryParams) Order(value string) {
-	p.order(value)
+func (p ListWebhooksQueryParams) Order(value SortOrder) {
+	p.order(string(value))
 }
 
 func (p ListWebhooksQueryParams) Paginate(page int, perPage int) {
@@ -66,10 +74,17 @@ func (p ListWebhooksQueryParams) Paginate(page int, perPage int) {
 
 // List webhooks. Reference: https://developer.aircall.io/api-references/#list-all-webhooks
 func (service *WebhookService) List(opts *ListWebhooksQueryParams) (*WebhooksResponse, *Response, error) {
+	return service.ListContext(context.Background(), opts)
+}
+
+// List webhooks using the given context. Reference: https://developer.aircall.io/api-references/#list-all-webhooks
+func (service *WebhookService) ListContext(ctx context.Context, opts *ListWebhooksQueryParams) (*WebhooksResponse, *Response, error) {
+	ctx = withOperation(ctx, "Webhook.List")
+
 	_url := "webhooks"
 
 	responseBody := new(WebhooksResponse)
-	response, err := service.client.Get(_url, opts, responseBody)
+	response, err := service.client.GetContext(ctx, _url, opts, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -78,6 +93,18 @@ func (service *WebhookService) List(opts *ListWebhooksQueryParams) (*WebhooksRes
 	return responseBody, response, nil
 }
 
+// Iterate over all webhooks, following pagination. An optional cap on the number
+// of items can be given. Reference: https://developer.aircall.io/api-references/#list-all-webhooks
+func (service *WebhookService) All(ctx context.Context, opts *ListWebhooksQueryParams, maxItems ...int) iter.Seq2[Webhook, error] {
+	ctx = withOperation(ctx, "Webhook.All")
+
+	_url := "webhooks"
+
+	return paginate(ctx, service.client, _url, opts, maxItems, func(page *WebhooksResponse) (*GenericResponseMeta, *[]Webhook) {
+		return page.Meta, page.Webhooks
+	})
+}
+
 //  ***********************************************************************************
 //  GET WEBHOOK
 //  https://developer.aircall.io/api-references/#retrieve-a-webhook
@@ -85,10 +112,17 @@ func (service *WebhookService) List(opts *ListWebhooksQueryParams) (*WebhooksRes
 
 // Get a webhook. Reference: https://developer.aircall.io/api-references/#retrieve-a-webhook
 func (service *WebhookService) Get(webhookID string) (*WebhookResponse, *Response, error) {
+	return service.GetContext(context.Background(), webhookID)
+}
+
+// Get a webhook using the given context. Reference: https://developer.aircall.io/api-references/#retrieve-a-webhook
+func (service *WebhookService) GetContext(ctx context.Context, webhookID string) (*WebhookResponse, *Response, error) {
+	ctx = withOperation(ctx, "Webhook.Get")
+
 	_url := fmt.Sprintf("webhooks/%s", webhookID)
 
 	responseBody := new(WebhookResponse)
-	response, err := service.client.Get(_url, nil, responseBody)
+	response, err := service.client.GetContext(ctx, _url, nil, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -104,10 +138,21 @@ func (service *WebhookService) Get(webhookID string) (*WebhookResponse, *Respons
 
 // Create a webhook. Reference: https://developer.aircall.io/api-references/#create-a-webhook
 func (service *WebhookService) Create(webhook *CreateUpdateWebhook) (*WebhookResponse, *Response, error) {
+	return service.CreateContext(context.Background(), webhook)
+}
+
+// Create a webhook using the given context. Reference: https://developer.aircall.io/api-references/#create-a-webhook
+func (service *WebhookService) CreateContext(ctx context.Context, webhook *CreateUpdateWebhook) (*WebhookResponse, *Response, error) {
+	ctx = withOperation(ctx, "Webhook.Create")
+
 	_url := "webhooks"
 
+	if err := webhook.ValidateEvents(); err != nil {
+		return nil, nil, err
+	}
+
 	responseBody := new(WebhookResponse)
-	response, err := service.client.Post(_url, webhook, responseBody)
+	response, err := service.client.PostContext(ctx, _url, webhook, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -123,10 +168,21 @@ func (service *WebhookService) Create(webhook *CreateUpdateWebhook) (*WebhookRes
 
 // Update a webhook. Reference: https://developer.aircall.io/api-references/#update-a-webhook
 func (service *WebhookService) Update(webhookID string, webhook *CreateUpdateWebhook) (*WebhookResponse, *Response, error) {
+	return service.UpdateContext(context.Background(), webhookID, webhook)
+}
+
+// Update a webhook using the given context. Reference: https://developer.aircall.io/api-references/#update-a-webhook
+func (service *WebhookService) UpdateContext(ctx context.Context, webhookID string, webhook *CreateUpdateWebhook) (*WebhookResponse, *Response, error) {
+	ctx = withOperation(ctx, "Webhook.Update")
+
 	_url := fmt.Sprintf("webhooks/%s", webhookID)
 
+	if err := webhook.ValidateEvents(); err != nil {
+		return nil, nil, err
+	}
+
 	responseBody := new(WebhookResponse)
-	response, err := service.client.Put(_url, webhook, responseBody)
+	response, err := service.client.PutContext(ctx, _url, webhook, responseBody)
 
 	if err != nil {
 		return nil, response, err
@@ -142,9 +198,16 @@ func (service *WebhookService) Update(webhookID string, webhook *CreateUpdateWeb
 
 // Delete a webhook. Reference: https://developer.aircall.io/api-references/#delete-a-webhook
 func (service *WebhookService) Delete(webhookID string) (*Response, error) {
+	return service.DeleteContext(context.Background(), webhookID)
+}
+
+// Delete a webhook using the given context. Reference: https://developer.aircall.io/api-references/#delete-a-webhook
+func (service *WebhookService) DeleteContext(ctx context.Context, webhookID string) (*Response, error) {
+	ctx = withOperation(ctx, "Webhook.Delete")
+
 	_url := fmt.Sprintf("webhooks/%s", webhookID)
 
-	response, err := service.client.Delete(_url)
+	response, err := service.client.DeleteContext(ctx, _url)
 
 	if err != nil {
 		return nil, err
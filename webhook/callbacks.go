@@ -0,0 +1,216 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/dinistavares/go-aircall-api"
+)
+
+// OnEvent registers a callback receiving every webhook decoded as a typed
+// event (see aircall.InboundWebhook.Decode)
+func (receiver *Receiver) OnEvent(callback func(ctx context.Context, event aircall.Event) error) {
+	receiver.OnAny(func(ctx context.Context, webhook *aircall.InboundWebhook) error {
+		event, err := webhook.Decode()
+		if err != nil {
+			return err
+		}
+
+		return callback(ctx, event)
+	})
+}
+
+// OnCall registers a callback receiving the call of a call event
+func (receiver *Receiver) OnCall(event aircall.WebhookEvent, callback func(ctx context.Context, call *aircall.Call) error) {
+	receiver.On(event, func(ctx context.Context, webhook *aircall.InboundWebhook) error {
+		call, err := webhook.GetCallData()
+		if err != nil {
+			return err
+		}
+
+		return callback(ctx, call)
+	})
+}
+
+// OnUser registers a callback receiving the user of a user event
+func (receiver *Receiver) OnUser(event aircall.WebhookEvent, callback func(ctx context.Context, user *aircall.User) error) {
+	receiver.On(event, func(ctx context.Context, webhook *aircall.InboundWebhook) error {
+		user, err := webhook.GetUserData()
+		if err != nil {
+			return err
+		}
+
+		return callback(ctx, user)
+	})
+}
+
+// OnContact registers a callback receiving the contact of a contact event
+func (receiver *Receiver) OnContact(event aircall.WebhookEvent, callback func(ctx context.Context, contact *aircall.Contact) error) {
+	receiver.On(event, func(ctx context.Context, webhook *aircall.InboundWebhook) error {
+		contact, err := webhook.GetContactData()
+		if err != nil {
+			return err
+		}
+
+		return callback(ctx, contact)
+	})
+}
+
+// OnNumber registers a callback receiving the number of a number event
+func (receiver *Receiver) OnNumber(event aircall.WebhookEvent, callback func(ctx context.Context, number *aircall.Number) error) {
+	receiver.On(event, func(ctx context.Context, webhook *aircall.InboundWebhook) error {
+		number, err := webhook.GetNumberData()
+		if err != nil {
+			return err
+		}
+
+		return callback(ctx, number)
+	})
+}
+
+// OnMessage registers a callback receiving the message of a message event
+func (receiver *Receiver) OnMessage(event aircall.WebhookEvent, callback func(ctx context.Context, message *aircall.Message) error) {
+	receiver.On(event, func(ctx context.Context, webhook *aircall.InboundWebhook) error {
+		message, err := webhook.GetMessageData()
+		if err != nil {
+			return err
+		}
+
+		return callback(ctx, message)
+	})
+}
+
+// OnIntegration registers a callback receiving the integration of an
+// integration event
+func (receiver *Receiver) OnIntegration(event aircall.WebhookEvent, callback func(ctx context.Context, integration *aircall.Integration) error) {
+	receiver.On(event, func(ctx context.Context, webhook *aircall.InboundWebhook) error {
+		integration, err := webhook.GetIntegrationData()
+		if err != nil {
+			return err
+		}
+
+		return callback(ctx, integration)
+	})
+}
+
+func (receiver *Receiver) OnCallCreated(callback func(ctx context.Context, call *aircall.Call) error) {
+	receiver.OnCall(aircall.WebhookEventCallCreated, callback)
+}
+
+func (receiver *Receiver) OnCallRingingOnAgent(callback func(ctx context.Context, call *aircall.Call) error) {
+	receiver.OnCall(aircall.WebhookEventCallRingingOnAgent, callback)
+}
+
+func (receiver *Receiver) OnCallAnswered(callback func(ctx context.Context, call *aircall.Call) error) {
+	receiver.OnCall(aircall.WebhookEventCallAnswered, callback)
+}
+
+func (receiver *Receiver) OnCallTransferred(callback func(ctx context.Context, call *aircall.Call) error) {
+	receiver.OnCall(aircall.WebhookEventCallTransferred, callback)
+}
+
+func (receiver *Receiver) OnCallHungup(callback func(ctx context.Context, call *aircall.Call) error) {
+	receiver.OnCall(aircall.WebhookEventCallHungup, callback)
+}
+
+func (receiver *Receiver) OnCallEnded(callback func(ctx context.Context, call *aircall.Call) error) {
+	receiver.OnCall(aircall.WebhookEventCallEnded, callback)
+}
+
+func (receiver *Receiver) OnCallVoicemailLeft(callback func(ctx context.Context, call *aircall.Call) error) {
+	receiver.OnCall(aircall.WebhookEventCallVoicemailLeft, callback)
+}
+
+func (receiver *Receiver) OnCallTagged(callback func(ctx context.Context, call *aircall.Call) error) {
+	receiver.OnCall(aircall.WebhookEventCallTagged, callback)
+}
+
+func (receiver *Receiver) OnCallCommented(callback func(ctx context.Context, call *aircall.Call) error) {
+	receiver.OnCall(aircall.WebhookEventCallCommented, callback)
+}
+
+func (receiver *Receiver) OnContactCreated(callback func(ctx context.Context, contact *aircall.Contact) error) {
+	receiver.OnContact(aircall.WebhookEventContactCreated, callback)
+}
+
+func (receiver *Receiver) OnContactUpdated(callback func(ctx context.Context, contact *aircall.Contact) error) {
+	receiver.OnContact(aircall.WebhookEventContactUpdated, callback)
+}
+
+func (receiver *Receiver) OnContactDeleted(callback func(ctx context.Context, contact *aircall.Contact) error) {
+	receiver.OnContact(aircall.WebhookEventContactDeleted, callback)
+}
+
+func (receiver *Receiver) OnUserOpened(callback func(ctx context.Context, user *aircall.User) error) {
+	receiver.OnUser(aircall.WebhookEventUserOpened, callback)
+}
+
+func (receiver *Receiver) OnUserClosed(callback func(ctx context.Context, user *aircall.User) error) {
+	receiver.OnUser(aircall.WebhookEventUserClosed, callback)
+}
+
+func (receiver *Receiver) OnMessageSent(callback func(ctx context.Context, message *aircall.Message) error) {
+	receiver.OnMessage(aircall.WebhookEventMessageSent, callback)
+}
+
+func (receiver *Receiver) OnMessageReceived(callback func(ctx context.Context, message *aircall.Message) error) {
+	receiver.OnMessage(aircall.WebhookEventMessageReceived, callback)
+}
+
+func (receiver *Receiver) OnMessageStatusUpdated(callback func(ctx context.Context, message *aircall.Message) error) {
+	receiver.OnMessage(aircall.WebhookEventMessageStatusUpdated, callback)
+}
+
+func (receiver *Receiver) OnSentimentCreated(callback func(ctx context.Context, sentiment *aircall.ConversationIntelligenceSentiment) error) {
+	receiver.On(aircall.WebhookEventSentimentCreated, func(ctx context.Context, webhook *aircall.InboundWebhook) error {
+		sentiment, err := webhook.GetConversationIntelligenceSentimentData()
+		if err != nil {
+			return err
+		}
+
+		return callback(ctx, sentiment)
+	})
+}
+
+func (receiver *Receiver) OnTranscriptionCreated(callback func(ctx context.Context, transcription *aircall.ConversationIntelligenceTranscription) error) {
+	receiver.On(aircall.WebhookEventTranscriptionCreated, func(ctx context.Context, webhook *aircall.InboundWebhook) error {
+		transcription, err := webhook.GetConversationIntelligenceTranscriptionData()
+		if err != nil {
+			return err
+		}
+
+		return callback(ctx, transcription)
+	})
+}
+
+func (receiver *Receiver) OnTopicCreated(callback func(ctx context.Context, topic *aircall.ConversationIntelligenceTopic) error) {
+	receiver.On(aircall.WebhookEventTopicCreated, func(ctx context.Context, webhook *aircall.InboundWebhook) error {
+		topic, err := webhook.GetConversationIntelligenceTopicData()
+		if err != nil {
+			return err
+		}
+
+		return callback(ctx, topic)
+	})
+}
+
+func (receiver *Receiver) OnSummaryCreated(callback func(ctx context.Context, summary *aircall.ConversationIntelligenceSummary) error) {
+	receiver.On(aircall.WebhookEventSummaryCreated, func(ctx context.Context, webhook *aircall.InboundWebhook) error {
+		summary, err := webhook.GetConversationIntelligenceSummaryData()
+		if err != nil {
+			return err
+		}
+
+		return callback(ctx, summary)
+	})
+}
+
+func (receiver *Receiver) OnActionItemCreated(callback func(ctx context.Context, actionItem *aircall.ConversationIntelligenceActionItem) error) {
+	receiver.On(aircall.WebhookEventActionItemCreated, func(ctx context.Context, webhook *aircall.InboundWebhook) error {
+		actionItem, err := webhook.GetConversationIntelligenceActionItemData()
+		if err != nil {
+			return err
+		}
+
+		return callback(ctx, actionItem)
+	})
+}
@@ -0,0 +1,283 @@
+package webhook
+
+import (
+	"bufio"
+	"container/list"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	defaultMemoryDedupCapacity = 10000
+
+	// Prefix of file log lines releasing a claimed delivery
+	fileDedupReleasePrefix = "-"
+)
+
+type duplicateContextKey struct{}
+
+// DedupStore remembers the webhook deliveries being processed or processed
+// (see aircall.InboundWebhook.DeliveryKey).
+type DedupStore interface {
+	// Claim atomically reserves the delivery. Returns false when it was
+	// already claimed, by a concurrent or a past delivery.
+	Claim(ctx context.Context, key string) (bool, error)
+
+	// Release forgets a claimed delivery whose processing failed, so it can
+	// be delivered again
+	Release(ctx context.Context, key string) error
+}
+
+// IsDuplicate reports whether the webhook being handled was already
+// claimed. Only set when the receiver flags duplicates instead of skipping
+// them.
+func IsDuplicate(ctx context.Context) bool {
+	duplicate, _ := ctx.Value(duplicateContextKey{}).(bool)
+
+	return duplicate
+}
+
+// MemoryDedupStore keeps the most recent deliveries in memory, evicting the
+// oldest ones past its capacity.
+type MemoryDedupStore struct {
+	mutex    sync.Mutex
+	capacity int
+	order    *list.List
+	keys     map[string]*list.Element
+}
+
+func NewMemoryDedupStore(capacity int) *MemoryDedupStore {
+	if capacity <= 0 {
+		capacity = defaultMemoryDedupCapacity
+	}
+
+	return &MemoryDedupStore{capacity: capacity, order: list.New(), keys: map[string]*list.Element{}}
+}
+
+func (store *MemoryDedupStore) Claim(ctx context.Context, key string) (bool, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	return store.claim(key), nil
+}
+
+func (store *MemoryDedupStore) Release(ctx context.Context, key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.release(key)
+
+	return nil
+}
+
+func (store *MemoryDedupStore) claim(key string) bool {
+	if _, ok := store.keys[key]; ok {
+		return false
+	}
+
+	store.keys[key] = store.order.PushFront(key)
+
+	for store.order.Len() > store.capacity {
+		oldest := store.order.Back()
+		store.order.Remove(oldest)
+		delete(store.keys, oldest.Value.(string))
+	}
+
+	return true
+}
+
+func (store *MemoryDedupStore) release(key string) {
+	if element, ok := store.keys[key]; ok {
+		store.order.Remove(element)
+		delete(store.keys, key)
+	}
+}
+
+// FileDedupStore keeps the most recent deliveries in memory and logs them to
+// a file so they survive restarts. Like MemoryDedupStore it holds at most
+// 'capacity' deliveries: the file is compacted once it logs twice as many.
+type FileDedupStore struct {
+	mutex  sync.Mutex
+	path   string
+	file   *os.File
+	memory *MemoryDedupStore
+	lines  int
+}
+
+// OpenFileDedupStore loads the deliveries logged in the file, creating it if
+// needed.
+func OpenFileDedupStore(path string, capacity int) (*FileDedupStore, error) {
+	store := &FileDedupStore{path: path, memory: NewMemoryDedupStore(capacity)}
+
+	existing, err := os.Open(path)
+
+	switch {
+	case err == nil:
+		scanner := bufio.NewScanner(existing)
+
+		for scanner.Scan() {
+			line := scanner.Text()
+
+			if key, ok := strings.CutPrefix(line, fileDedupReleasePrefix); ok {
+				store.memory.release(key)
+			} else if line != "" {
+				store.memory.claim(line)
+			}
+		}
+
+		existing.Close()
+
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, err
+	}
+
+	// Start from a compact log
+	if err := store.compact(); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (store *FileDedupStore) Claim(ctx context.Context, key string) (bool, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if !store.memory.claim(key) {
+		return false, nil
+	}
+
+	if err := store.log(key); err != nil {
+		store.memory.release(key)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (store *FileDedupStore) Release(ctx context.Context, key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.memory.release(key)
+
+	return store.log(fileDedupReleasePrefix + key)
+}
+
+func (store *FileDedupStore) Close() error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	return store.file.Close()
+}
+
+func (store *FileDedupStore) log(line string) error {
+	if _, err := fmt.Fprintln(store.file, line); err != nil {
+		return err
+	}
+
+	store.lines++
+
+	if store.lines > 2*store.memory.capacity {
+		return store.compact()
+	}
+
+	return nil
+}
+
+// compact rewrites the log with the deliveries held, oldest first
+func (store *FileDedupStore) compact() error {
+	temporary, err := os.CreateTemp(filepath.Dir(store.path), filepath.Base(store.path)+".*")
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(temporary)
+
+	for element := store.memory.order.Back(); element != nil; element = element.Prev() {
+		fmt.Fprintln(writer, element.Value.(string))
+	}
+
+	if err := writer.Flush(); err != nil {
+		temporary.Close()
+		os.Remove(temporary.Name())
+		return err
+	}
+
+	if err := temporary.Chmod(0600); err != nil {
+		temporary.Close()
+		os.Remove(temporary.Name())
+		return err
+	}
+
+	if err := os.Rename(temporary.Name(), store.path); err != nil {
+		temporary.Close()
+		os.Remove(temporary.Name())
+		return err
+	}
+
+	if store.file != nil {
+		store.file.Close()
+	}
+
+	store.file = temporary
+	store.lines = store.memory.order.Len()
+
+	return nil
+}
+
+// SQLDedupStore keeps deliveries in a SQLite table, with the driver chosen
+// by the caller. Deliveries are never deleted unless released: purge old rows
+// with 'created_at' as needed.
+type SQLDedupStore struct {
+	db    *sql.DB
+	table string
+}
+
+// NewSQLDedupStore creates the table if needed. Queries use SQLite syntax
+// ('?' placeholders, 'ON CONFLICT DO NOTHING').
+func NewSQLDedupStore(ctx context.Context, db *sql.DB, table string) (*SQLDedupStore, error) {
+	store := &SQLDedupStore{db: db, table: table}
+
+	query := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (delivery_key TEXT PRIMARY KEY, created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP)", table)
+
+	if _, err := db.ExecContext(ctx, query); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (store *SQLDedupStore) Claim(ctx context.Context, key string) (bool, error) {
+	query := fmt.Sprintf("INSERT INTO %s (delivery_key) VALUES (?) ON CONFLICT DO NOTHING", store.table)
+
+	result, err := store.db.ExecContext(ctx, query, key)
+	if err != nil {
+		return false, err
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return inserted == 1, nil
+}
+
+func (store *SQLDedupStore) Release(ctx context.Context, key string) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE delivery_key = ?", store.table)
+
+	_, err := store.db.ExecContext(ctx, query, key)
+
+	return err
+}
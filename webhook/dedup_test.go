@@ -0,0 +1,199 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/dinistavares/go-aircall-api"
+)
+
+func claim(t *testing.T, store DedupStore, key string) bool {
+	t.Helper()
+
+	claimed, err := store.Claim(context.Background(), key)
+	if err != nil {
+		t.Fatalf("Claim(%q) error = %v", key, err)
+	}
+
+	return claimed
+}
+
+func TestMemoryDedupStoreClaimsOnce(t *testing.T) {
+	store := NewMemoryDedupStore(10)
+
+	var claimed atomic.Int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if ok, _ := store.Claim(context.Background(), "call:call.ended:1:100"); ok {
+				claimed.Add(1)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if claimed.Load() != 1 {
+		t.Errorf("%d concurrent claims succeeded, want 1", claimed.Load())
+	}
+
+	store.Release(context.Background(), "call:call.ended:1:100")
+
+	if !claim(t, store, "call:call.ended:1:100") {
+		t.Errorf("Claim() after Release() = false, want true")
+	}
+}
+
+func TestMemoryDedupStoreEvictsOldest(t *testing.T) {
+	store := NewMemoryDedupStore(2)
+
+	for _, key := range []string{"a", "b", "c"} {
+		claim(t, store, key)
+	}
+
+	if !claim(t, store, "a") {
+		t.Errorf("Claim(a) = false, want true once evicted")
+	}
+
+	if claim(t, store, "c") {
+		t.Errorf("Claim(c) = true, want false")
+	}
+}
+
+func TestFileDedupStoreReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deliveries")
+
+	store, err := OpenFileDedupStore(path, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"a", "b", "c"} {
+		claim(t, store, key)
+	}
+
+	if err := store.Release(context.Background(), "b"); err != nil {
+		t.Fatal(err)
+	}
+
+	store.Close()
+
+	store, err = OpenFileDedupStore(path, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer store.Close()
+
+	want := map[string]bool{"a": false, "b": true, "c": false, "d": true}
+
+	for _, key := range []string{"a", "b", "c", "d"} {
+		if got := claim(t, store, key); got != want[key] {
+			t.Errorf("Claim(%q) after reopening = %v, want %v", key, got, want[key])
+		}
+	}
+}
+
+func TestFileDedupStoreCompacts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deliveries")
+	capacity := 3
+
+	store, err := OpenFileDedupStore(path, capacity)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Enough claims and releases to compact several times
+	for i := 0; i < 20; i++ {
+		key := fmt.Sprintf("key-%d", i)
+
+		claim(t, store, key)
+
+		if i%4 == 3 {
+			if err := store.Release(context.Background(), key); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	store.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if lines := bytes.Count(data, []byte("\n")); lines > 2*capacity {
+		t.Errorf("log holds %d lines, want at most %d", lines, 2*capacity)
+	}
+
+	store, err = OpenFileDedupStore(path, capacity)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer store.Close()
+
+	// Last 3 claims kept (key-19 released afterwards), older ones evicted.
+	// Checked in order, successful claims evict in turn.
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{"key-17", false},
+		{"key-18", false},
+		{"key-19", true},
+		{"key-16", true},
+	}
+
+	for _, test := range tests {
+		if got := claim(t, store, test.key); got != test.want {
+			t.Errorf("Claim(%q) after compaction = %v, want %v", test.key, got, test.want)
+		}
+	}
+}
+
+func TestReceiverReleasesFailedDelivery(t *testing.T) {
+	receiver := NewReceiver("secret")
+	receiver.Dedup = NewMemoryDedupStore(10)
+
+	calls := 0
+
+	receiver.OnAny(func(ctx context.Context, webhook *aircall.InboundWebhook) error {
+		calls++
+
+		// Fail the first delivery
+		if calls == 1 {
+			return errors.New("database unavailable")
+		}
+
+		return nil
+	})
+
+	body := webhookBody(aircall.WebhookEventCallEnded, "secret")
+
+	for i, want := range []int{http.StatusInternalServerError, http.StatusOK, http.StatusOK} {
+		if code := deliver(receiver, strings.NewReader(body)); code != want {
+			t.Errorf("delivery %d answered %d, want %d", i+1, code, want)
+		}
+	}
+
+	// Retried after the failure, then skipped as a duplicate
+	if calls != 2 {
+		t.Errorf("handler called %d times, want 2", calls)
+	}
+}
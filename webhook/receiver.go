@@ -0,0 +1,226 @@
+// Package webhook provides an http.Handler receiving Aircall webhooks: it
+// verifies the webhook token and dispatches events to typed callbacks.
+package webhook
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/dinistavares/go-aircall-api"
+)
+
+const (
+	// Webhook payloads larger than this are rejected
+	defaultMaxBodyBytes = 5 << 20
+)
+
+var (
+	ErrInvalidToken = errors.New("webhook: invalid token")
+)
+
+// Handler processes an inbound webhook. Returning an error responds with
+// HTTP 500 so Aircall delivers the webhook again.
+type Handler func(ctx context.Context, webhook *aircall.InboundWebhook) error
+
+// Receiver is an http.Handler for Aircall webhooks. Several tokens can be
+// registered at once, eg. while rotating webhooks.
+type Receiver struct {
+	mutex    sync.RWMutex
+	tokens   [][]byte
+	handlers map[aircall.WebhookEvent][]Handler
+	fallback []Handler
+
+	// Maximum accepted payload size. Defaults to 5MB.
+	MaxBodyBytes int64
+
+	// Called when a webhook cannot be processed. Defaults to no-op.
+	OnError func(req *http.Request, err error)
+
+	// Claims deliveries so concurrent or later Aircall retries are not
+	// processed twice. Disabled when nil.
+	Dedup DedupStore
+
+	// Dispatch duplicates with IsDuplicate(ctx) set, instead of skipping them
+	FlagDuplicates bool
+}
+
+// NewReceiver creates a receiver accepting webhooks signed with any of the
+// given tokens (see aircall.Webhook.Token).
+func NewReceiver(tokens ...string) *Receiver {
+	receiver := &Receiver{handlers: map[aircall.WebhookEvent][]Handler{}}
+
+	for _, token := range tokens {
+		receiver.AddToken(token)
+	}
+
+	return receiver
+}
+
+// AddToken accepts webhooks carrying the token
+func (receiver *Receiver) AddToken(token string) {
+	if token == "" {
+		return
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.tokens = append(receiver.tokens, []byte(token))
+}
+
+// RemoveToken stops accepting webhooks carrying the token
+func (receiver *Receiver) RemoveToken(token string) {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	tokens := receiver.tokens[:0]
+
+	for _, registered := range receiver.tokens {
+		if string(registered) != token {
+			tokens = append(tokens, registered)
+		}
+	}
+
+	receiver.tokens = tokens
+}
+
+// On registers a handler for an event
+func (receiver *Receiver) On(event aircall.WebhookEvent, handler Handler) {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.handlers[event] = append(receiver.handlers[event], handler)
+}
+
+// OnAny registers a handler for every event
+func (receiver *Receiver) OnAny(handler Handler) {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.fallback = append(receiver.fallback, handler)
+}
+
+// Verify checks the token in constant time against every registered token
+func (receiver *Receiver) Verify(token string) bool {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	valid := 0
+
+	for _, registered := range receiver.tokens {
+		valid |= subtle.ConstantTimeCompare(registered, []byte(token))
+	}
+
+	return valid == 1
+}
+
+func (receiver *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	maxBodyBytes := receiver.MaxBodyBytes
+
+	if maxBodyBytes <= 0 {
+		maxBodyBytes = defaultMaxBodyBytes
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxBodyBytes))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+
+		// Too large, or the client went away mid body
+		if errors.As(err, &maxBytesErr) {
+			receiver.fail(w, req, err, http.StatusRequestEntityTooLarge)
+		} else {
+			receiver.fail(w, req, err, http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	webhook := new(aircall.InboundWebhook)
+
+	if err := json.Unmarshal(data, webhook); err != nil {
+		receiver.fail(w, req, err, http.StatusBadRequest)
+		return
+	}
+
+	if !receiver.Verify(webhook.Token) {
+		receiver.fail(w, req, ErrInvalidToken, http.StatusUnauthorized)
+		return
+	}
+
+	if err := receiver.dispatchOnce(req.Context(), webhook); err != nil {
+		receiver.fail(w, req, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// dispatchOnce dispatches the webhook unless already claimed. Deliveries
+// are claimed before being handled, and released when handling fails so they
+// can be retried.
+func (receiver *Receiver) dispatchOnce(ctx context.Context, webhook *aircall.InboundWebhook) error {
+	if receiver.Dedup == nil {
+		return receiver.Dispatch(ctx, webhook)
+	}
+
+	key := webhook.DeliveryKey()
+
+	claimed, err := receiver.Dedup.Claim(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if !claimed {
+		if !receiver.FlagDuplicates {
+			return nil
+		}
+
+		return receiver.Dispatch(context.WithValue(ctx, duplicateContextKey{}, true), webhook)
+	}
+
+	if err := receiver.Dispatch(ctx, webhook); err != nil {
+		if releaseErr := receiver.Dedup.Release(ctx, key); releaseErr != nil {
+			return errors.Join(err, releaseErr)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Dispatch runs the handlers registered for the webhook event, without
+// verifying its token. Stops at the first handler error.
+func (receiver *Receiver) Dispatch(ctx context.Context, webhook *aircall.InboundWebhook) error {
+	receiver.mutex.RLock()
+	handlers := append(append([]Handler(nil), receiver.handlers[webhook.Event]...), receiver.fallback...)
+	receiver.mutex.RUnlock()
+
+	for _, handler := range handlers {
+		if err := handler(ctx, webhook); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (receiver *Receiver) fail(w http.ResponseWriter, req *http.Request, err error, status int) {
+	if receiver.OnError != nil {
+		receiver.OnError(req, err)
+	}
+
+	http.Error(w, http.StatusText(status), status)
+}
@@ -0,0 +1,177 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/dinistavares/go-aircall-api"
+)
+
+// webhookBody returns a call event payload carrying the token
+func webhookBody(event aircall.WebhookEvent, token string) string {
+	return `{"resource":"call","event":"` + string(event) + `","timestamp":100,"token":"` + token + `","data":{"id":1}}`
+}
+
+// deliver posts the body to the receiver and returns the response status
+func deliver(receiver *Receiver, body io.Reader) int {
+	recorder := httptest.NewRecorder()
+	receiver.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", body))
+
+	return recorder.Code
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, io.ErrUnexpectedEOF
+}
+
+func TestReceiverVerifiesToken(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		want  int
+	}{
+		{"valid", "secret", http.StatusOK},
+		{"invalid", "guess", http.StatusUnauthorized},
+		{"missing", "", http.StatusUnauthorized},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			receiver := NewReceiver("secret")
+
+			var errs []error
+
+			receiver.OnError = func(req *http.Request, err error) {
+				errs = append(errs, err)
+			}
+
+			called := false
+
+			receiver.OnAny(func(ctx context.Context, webhook *aircall.InboundWebhook) error {
+				called = true
+				return nil
+			})
+
+			if code := deliver(receiver, strings.NewReader(webhookBody(aircall.WebhookEventCallEnded, test.token))); code != test.want {
+				t.Errorf("delivery answered %d, want %d", code, test.want)
+			}
+
+			if accepted := test.want == http.StatusOK; called != accepted {
+				t.Errorf("handler called = %v, want %v", called, accepted)
+			}
+
+			if test.want == http.StatusUnauthorized && (len(errs) != 1 || !errors.Is(errs[0], ErrInvalidToken)) {
+				t.Errorf("OnError() errors = %v, want ErrInvalidToken", errs)
+			}
+		})
+	}
+}
+
+func TestReceiverRotatesTokens(t *testing.T) {
+	receiver := NewReceiver("old")
+
+	// Empty tokens never accept anything
+	receiver.AddToken("")
+	receiver.AddToken("new")
+
+	steps := []struct {
+		token string
+		want  int
+	}{
+		{"old", http.StatusOK},
+		{"new", http.StatusOK},
+		{"", http.StatusUnauthorized},
+	}
+
+	for _, step := range steps {
+		if code := deliver(receiver, strings.NewReader(webhookBody(aircall.WebhookEventCallEnded, step.token))); code != step.want {
+			t.Errorf("token %q answered %d, want %d", step.token, code, step.want)
+		}
+	}
+
+	receiver.RemoveToken("old")
+
+	if code := deliver(receiver, strings.NewReader(webhookBody(aircall.WebhookEventCallEnded, "old"))); code != http.StatusUnauthorized {
+		t.Errorf("removed token answered %d, want %d", code, http.StatusUnauthorized)
+	}
+
+	if code := deliver(receiver, strings.NewReader(webhookBody(aircall.WebhookEventCallEnded, "new"))); code != http.StatusOK {
+		t.Errorf("remaining token answered %d, want %d", code, http.StatusOK)
+	}
+}
+
+func TestReceiverDispatch(t *testing.T) {
+	receiver := NewReceiver("secret")
+
+	called := []string{}
+
+	record := func(name string, err error) Handler {
+		return func(ctx context.Context, webhook *aircall.InboundWebhook) error {
+			called = append(called, name)
+			return err
+		}
+	}
+
+	receiver.On(aircall.WebhookEventCallCreated, record("created", nil))
+	receiver.On(aircall.WebhookEventCallEnded, record("ended", nil))
+	receiver.OnAny(record("any", nil))
+
+	if code := deliver(receiver, strings.NewReader(webhookBody(aircall.WebhookEventCallEnded, "secret"))); code != http.StatusOK {
+		t.Fatalf("delivery answered %d, want %d", code, http.StatusOK)
+	}
+
+	// Event handlers first, then the fallback
+	if want := []string{"ended", "any"}; !slices.Equal(called, want) {
+		t.Errorf("handlers called = %v, want %v", called, want)
+	}
+
+	// A failing handler stops the dispatch and has Aircall retry
+	called = []string{}
+	receiver.On(aircall.WebhookEventCallEnded, record("failing", errors.New("database unavailable")))
+
+	if code := deliver(receiver, strings.NewReader(webhookBody(aircall.WebhookEventCallEnded, "secret"))); code != http.StatusInternalServerError {
+		t.Errorf("delivery answered %d, want %d", code, http.StatusInternalServerError)
+	}
+
+	if want := []string{"ended", "failing"}; !slices.Equal(called, want) {
+		t.Errorf("handlers called = %v, want %v", called, want)
+	}
+}
+
+func TestReceiverRejectsRequests(t *testing.T) {
+	receiver := NewReceiver("secret")
+	receiver.MaxBodyBytes = 64
+
+	tests := []struct {
+		name string
+		body io.Reader
+		want int
+	}{
+		{"too large", strings.NewReader(webhookBody(aircall.WebhookEventCallEnded, "secret") + strings.Repeat(" ", 64)), http.StatusRequestEntityTooLarge},
+		{"read error", failingReader{}, http.StatusBadRequest},
+		{"invalid JSON", strings.NewReader(`{"event":`), http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if code := deliver(receiver, test.body); code != test.want {
+				t.Errorf("delivery answered %d, want %d", code, test.want)
+			}
+		})
+	}
+
+	recorder := httptest.NewRecorder()
+	receiver.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if recorder.Code != http.StatusMethodNotAllowed || recorder.Header().Get("Allow") != http.MethodPost {
+		t.Errorf("GET answered %d with Allow %q, want %d with POST", recorder.Code, recorder.Header().Get("Allow"), http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,129 @@
+package aircall
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"strconv"
+	"time"
+)
+
+const (
+	// Aircall refuses to paginate past this number of items
+	paginationMaxItems = 10000
+
+	// Smallest window that can be bisected (Aircall filters by the second)
+	windowMinSpan = 2 * time.Second
+)
+
+var (
+	ErrWindowTooDense = errors.New("aircall: more items in a one second window than pagination allows")
+)
+
+// Query parameters of a single date window.
+type windowQueryParams struct {
+	QueryValues
+}
+
+// paginateWindows iterates over the items of a list endpoint between 'from'
+// and 'to'. Windows holding more items than Aircall paginates through are
+// bisected until each one fits; items duplicated on window boundaries are
+// yielded once.
+func paginateWindows[R any, T any](ctx context.Context, client *Client, url string, values QueryValues, from, to time.Time, page func(*R) (*GenericResponseMeta, *[]T), id func(T) int) iter.Seq2[T, error] {
+	descending := values.get("order") == "desc"
+
+	return func(yield func(T, error) bool) {
+		var previous, current map[int]struct{}
+
+		// emit yields an item unless seen in this or the adjacent window
+		emit := func(item T) bool {
+			key := id(item)
+
+			if _, ok := previous[key]; ok {
+				return true
+			}
+
+			if _, ok := current[key]; ok {
+				return true
+			}
+
+			current[key] = struct{}{}
+
+			return yield(item, nil)
+		}
+
+		var window func(from, to time.Time) bool
+
+		window = func(from, to time.Time) bool {
+			opts := &windowQueryParams{QueryValues: values.clone()}
+
+			// Every window starts on its first page
+			delete(opts.QueryValues, "page")
+
+			opts.from(strconv.FormatInt(from.Unix(), 10))
+			opts.to(strconv.FormatInt(to.Unix(), 10))
+
+			responseBody := new(R)
+
+			_, err := client.GetContext(ctx, url, opts, responseBody)
+			if err != nil {
+				var zero T
+
+				yield(zero, err)
+				return false
+			}
+
+			meta, items := page(responseBody)
+
+			// Too many items? (bisect the window, keeping the requested order)
+			if meta != nil && meta.Total > paginationMaxItems {
+				if to.Sub(from) < windowMinSpan {
+					var zero T
+
+					yield(zero, ErrWindowTooDense)
+					return false
+				}
+
+				middle := from.Add(to.Sub(from) / 2).Truncate(time.Second)
+
+				if descending {
+					return window(middle, to) && window(from, middle)
+				}
+
+				return window(from, middle) && window(middle, to)
+			}
+
+			previous, current = current, map[int]struct{}{}
+
+			if items != nil {
+				for _, item := range *items {
+					if !emit(item) {
+						return false
+					}
+				}
+			}
+
+			if meta == nil || meta.NextPageLink == "" {
+				return true
+			}
+
+			// Follow remaining pages of this window
+			for item, err := range paginate(ctx, client, meta.NextPageLink, nil, nil, page) {
+				if err != nil {
+					var zero T
+
+					yield(zero, err)
+					return false
+				}
+
+				if !emit(item) {
+					return false
+				}
+			}
+
+			return true
+		}
+
+		window(from, to)
+	}
+}
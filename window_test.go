@@ -0,0 +1,88 @@
+package aircall_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dinistavares/go-aircall-api"
+	"github.com/dinistavares/go-aircall-api/aircalltest"
+)
+
+func TestAllBetweenBisectsWindows(t *testing.T) {
+	server := aircalltest.NewServer()
+	defer server.Close()
+
+	// More calls than Aircall paginates through, one per second so window
+	// boundaries land on calls
+	start := time.Unix(1717171200, 0)
+	server.SeedCalls(12000, start, time.Second)
+
+	tests := []struct {
+		name  string
+		order aircall.SortOrder
+	}{
+		{"ascending", aircall.SortAscending},
+		{"descending", aircall.SortDescending},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := server.Client()
+
+			opts := client.Call.Query().NewListCalls()
+			opts.Order(test.order)
+
+			// A caller set page must not skip the start of every window
+			opts.Paginate(3, 50)
+
+			seen := map[int]bool{}
+			previous := 0
+
+			for call, err := range client.Call.AllBetween(context.Background(), opts, start, start.Add(12000*time.Second)) {
+				if err != nil {
+					t.Fatalf("AllBetween() error = %v", err)
+				}
+
+				if seen[call.ID] {
+					t.Fatalf("call %d yielded twice", call.ID)
+				}
+
+				// Windows follow each other in the requested order
+				if previous != 0 && (call.StartedAt > previous) == (test.order == aircall.SortDescending) {
+					t.Fatalf("call %d started at %d after %d, want %s order", call.ID, call.StartedAt, previous, test.order)
+				}
+
+				seen[call.ID] = true
+				previous = call.StartedAt
+			}
+
+			if len(seen) != 12000 {
+				t.Errorf("AllBetween() yielded %d calls, want 12000", len(seen))
+			}
+		})
+	}
+}
+
+func TestAllBetweenTooDense(t *testing.T) {
+	server := aircalltest.NewServer()
+	defer server.Close()
+
+	start := time.Unix(1717171200, 0)
+	server.SeedCalls(10001, start, 0)
+
+	client := server.Client()
+
+	var err error
+
+	for _, err = range client.Call.AllBetween(context.Background(), nil, start, start.Add(time.Second)) {
+		if err != nil {
+			break
+		}
+	}
+
+	if !errors.Is(err, aircall.ErrWindowTooDense) {
+		t.Errorf("AllBetween() error = %v, want ErrWindowTooDense", err)
+	}
+}